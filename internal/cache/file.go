@@ -0,0 +1,256 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fileSweepInterval is how often writes also remove every expired file
+const fileSweepInterval = 10 * time.Minute
+
+// fileEntry is the on-disk representation of a cached value
+type fileEntry struct {
+	Key       string    `json:"key"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Value     []byte    `json:"value"`
+}
+
+// fileMeta is what the file cache keeps in memory about each file it holds
+type fileMeta struct {
+	expiresAt time.Time
+	usedAt    time.Time
+}
+
+// File is a Store that keeps one JSON file per key in a directory, so cached
+// values survive process restarts. It holds at most maxEntries files, evicting
+// the least recently used one when full. Expired files are removed on read,
+// when the store is opened and by a sweep that writes run every fileSweepInterval.
+type File struct {
+	dir        string
+	maxEntries int
+
+	mu        sync.Mutex
+	files     map[string]*fileMeta
+	lastSweep time.Time
+}
+
+// NewFile creates a file-backed store rooted at dir holding at most maxEntries values,
+// creating the directory if needed. Files left by a previous run are indexed and
+// expired or unreadable ones removed.
+func NewFile(dir string, maxEntries int) (*File, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("cache directory is required")
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	f := &File{
+		dir:        dir,
+		maxEntries: maxEntries,
+		files:      make(map[string]*fileMeta),
+		lastSweep:  time.Now(),
+	}
+
+	if err := f.load(); err != nil {
+		return nil, fmt.Errorf("failed to read cache directory: %w", err)
+	}
+
+	f.mu.Lock()
+	f.evict()
+	f.mu.Unlock()
+
+	return f, nil
+}
+
+// Get returns the value stored under key, if present and not expired
+func (f *File) Get(key string) ([]byte, bool) {
+	name := fileName(key)
+	path := filepath.Join(f.dir, name)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	var entry fileEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.Key != key {
+		return nil, false
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if time.Now().After(entry.ExpiresAt) {
+		f.remove(name)
+		return nil, false
+	}
+
+	if meta, ok := f.files[name]; ok {
+		meta.usedAt = time.Now()
+	}
+
+	return entry.Value, true
+}
+
+// Set stores value under key for the given TTL, evicting the least recently used file if full.
+// The file is written to a temporary name and renamed so readers never see partial data.
+func (f *File) Set(key string, value []byte, ttl time.Duration) {
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+
+	data, err := json.Marshal(fileEntry{
+		Key:       key,
+		ExpiresAt: expiresAt,
+		Value:     value,
+	})
+	if err != nil {
+		return
+	}
+
+	tmp, err := os.CreateTemp(f.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return
+	}
+
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return
+	}
+
+	name := fileName(key)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := os.Rename(tmp.Name(), filepath.Join(f.dir, name)); err != nil {
+		_ = os.Remove(tmp.Name())
+		return
+	}
+
+	f.files[name] = &fileMeta{expiresAt: expiresAt, usedAt: now}
+
+	if now.Sub(f.lastSweep) >= fileSweepInterval {
+		f.sweep(now)
+	}
+	f.evict()
+}
+
+// Delete removes the value stored under key
+func (f *File) Delete(key string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.remove(fileName(key))
+}
+
+// Len returns the number of files currently held, including expired ones not yet removed
+func (f *File) Len() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.files)
+}
+
+// load indexes the files already in the directory, removing expired, unreadable and temporary ones.
+// A file's modification time stands in for its last use.
+func (f *File) load() error {
+	dirEntries, err := os.ReadDir(f.dir)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, dirEntry := range dirEntries {
+		name := dirEntry.Name()
+		path := filepath.Join(f.dir, name)
+
+		if dirEntry.IsDir() {
+			continue
+		}
+		if strings.HasPrefix(name, ".tmp-") {
+			_ = os.Remove(path)
+			continue
+		}
+		if filepath.Ext(name) != ".json" {
+			continue
+		}
+
+		info, err := dirEntry.Info()
+		if err != nil {
+			continue
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+
+		var entry fileEntry
+		if err := json.Unmarshal(data, &entry); err != nil || fileName(entry.Key) != name || now.After(entry.ExpiresAt) {
+			_ = os.Remove(path)
+			continue
+		}
+
+		f.files[name] = &fileMeta{expiresAt: entry.ExpiresAt, usedAt: info.ModTime()}
+	}
+
+	return nil
+}
+
+// sweep removes every expired file; the caller must hold the lock
+func (f *File) sweep(now time.Time) {
+	for name, meta := range f.files {
+		if now.After(meta.expiresAt) {
+			f.remove(name)
+		}
+	}
+	f.lastSweep = now
+}
+
+// evict removes the least recently used files until at most maxEntries remain,
+// sweeping expired files first; the caller must hold the lock
+func (f *File) evict() {
+	if f.maxEntries <= 0 || len(f.files) <= f.maxEntries {
+		return
+	}
+
+	f.sweep(time.Now())
+
+	for len(f.files) > f.maxEntries {
+		var oldest string
+		var oldestUsedAt time.Time
+		for name, meta := range f.files {
+			if oldest == "" || meta.usedAt.Before(oldestUsedAt) {
+				oldest, oldestUsedAt = name, meta.usedAt
+			}
+		}
+		f.remove(oldest)
+	}
+}
+
+// remove deletes a file and forgets it; the caller must hold the lock
+func (f *File) remove(name string) {
+	_ = os.Remove(filepath.Join(f.dir, name))
+	delete(f.files, name)
+}
+
+// fileName returns the file name for key; keys are hashed so any string is a safe file name
+func fileName(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:]) + ".json"
+}
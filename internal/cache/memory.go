@@ -0,0 +1,111 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// memoryEntry is a single value held by the memory cache
+type memoryEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// Memory is a size-bounded in-memory cache with per-entry TTL and LRU eviction.
+// It is bounded by the number of entries or by the total size of their values.
+type Memory struct {
+	mu         sync.Mutex
+	maxEntries int
+	maxBytes   int64
+	bytes      int64
+	ll         *list.List
+	items      map[string]*list.Element
+}
+
+// NewMemory creates a new in-memory cache holding at most maxEntries values
+func NewMemory(maxEntries int) *Memory {
+	return &Memory{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+// NewMemoryBytes creates a new in-memory cache holding values of at most maxBytes in total.
+// Values larger than maxBytes are not stored.
+func NewMemoryBytes(maxBytes int64) *Memory {
+	return &Memory{
+		maxBytes: maxBytes,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// Get returns the value stored under key, if present and not expired
+func (m *Memory) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*memoryEntry)
+	if time.Now().After(entry.expiresAt) {
+		m.removeElement(elem)
+		return nil, false
+	}
+
+	m.ll.MoveToFront(elem)
+	return entry.value, true
+}
+
+// Set stores value under key for the given TTL, evicting the least recently used entries if full
+func (m *Memory) Set(key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if elem, ok := m.items[key]; ok {
+		m.removeElement(elem)
+	}
+	if m.maxBytes > 0 && int64(len(value)) > m.maxBytes {
+		return
+	}
+
+	elem := m.ll.PushFront(&memoryEntry{key: key, value: value, expiresAt: time.Now().Add(ttl)})
+	m.items[key] = elem
+	m.bytes += int64(len(value))
+
+	for (m.maxEntries > 0 && m.ll.Len() > m.maxEntries) || (m.maxBytes > 0 && m.bytes > m.maxBytes) {
+		m.removeElement(m.ll.Back())
+	}
+}
+
+// Delete removes the value stored under key
+func (m *Memory) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if elem, ok := m.items[key]; ok {
+		m.removeElement(elem)
+	}
+}
+
+// Len returns the number of entries currently held, including expired ones not yet evicted
+func (m *Memory) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.ll.Len()
+}
+
+// removeElement unlinks an element from the list and index; the caller must hold the lock
+func (m *Memory) removeElement(elem *list.Element) {
+	entry := elem.Value.(*memoryEntry)
+	m.ll.Remove(elem)
+	delete(m.items, entry.key)
+	m.bytes -= int64(len(entry.value))
+}
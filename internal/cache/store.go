@@ -0,0 +1,36 @@
+package cache
+
+import (
+	"fmt"
+	"time"
+)
+
+// Supported cache backends
+const (
+	BackendMemory = "memory"
+	BackendFile   = "file"
+)
+
+// Store is a key/value store for cached upstream responses
+type Store interface {
+	// Get returns the value stored under key, if present and not expired
+	Get(key string) ([]byte, bool)
+
+	// Set stores value under key for the given TTL
+	Set(key string, value []byte, ttl time.Duration)
+
+	// Delete removes the value stored under key
+	Delete(key string)
+}
+
+// NewStore creates the store for the given backend
+func NewStore(backend, dir string, maxEntries int) (Store, error) {
+	switch backend {
+	case BackendMemory:
+		return NewMemory(maxEntries), nil
+	case BackendFile:
+		return NewFile(dir, maxEntries)
+	default:
+		return nil, fmt.Errorf("unknown cache backend %q", backend)
+	}
+}
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// FetchAbility fetches an ability from the PokeAPI
+func (c *PokeAPIClient) FetchAbility(ctx context.Context, name string) (*domain.AbilityDetails, error) {
+	url := fmt.Sprintf("%s/ability/%s", c.baseURL, strings.ToLower(name))
+
+	c.logger.Debug("Fetching ability",
+		zap.String("name", name),
+		zap.String("url", url),
+	)
+
+	var ability domain.AbilityDetails
+	if err := c.getJSON(ctx, url, &ability); err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			c.logger.Debug("Ability not found", zap.String("name", name))
+			return nil, domain.NotFound("ability")
+		}
+		c.logger.Error("Failed to fetch ability",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, externalError(err)
+	}
+
+	return &ability, nil
+}
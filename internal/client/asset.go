@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxAssetBytes caps the size of a downloaded asset
+	maxAssetBytes = 8 << 20
+
+	// assetAccept is the Accept header sent for assets, which are images and audio
+	assetAccept = "image/*, audio/*;q=0.9, */*;q=0.1"
+)
+
+// errAssetTooLarge is returned when an asset exceeds maxAssetBytes
+var errAssetTooLarge = fmt.Errorf("asset exceeds %d bytes", maxAssetBytes)
+
+// FetchAsset downloads a binary file referenced by PokeAPI data, such as a cry.
+// Like JSON requests it is retried and shared between concurrent callers, but it
+// is guarded by its own circuit breaker and rate limiter and is never revalidated.
+func (c *PokeAPIClient) FetchAsset(ctx context.Context, url string) ([]byte, error) {
+	c.logger.Debug("Fetching asset",
+		zap.String("url", url),
+	)
+
+	body, err := c.assetFlights.Do(ctx, normalizeURL(url), func(ctx context.Context) ([]byte, error) {
+		return c.doRequestWithRetry(ctx, url, c.assetBreaker, c.assetLimiter, c.doAssetRequest)
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			c.logger.Debug("Asset not found", zap.String("url", url))
+			return nil, domain.NotFound("asset")
+		}
+		c.logger.Error("Failed to fetch asset",
+			zap.String("url", url),
+			zap.Error(err),
+		)
+		return nil, externalError(err)
+	}
+
+	return body, nil
+}
+
+// doAssetRequest performs a single HTTP GET request for an asset and returns at most maxAssetBytes of body
+func (c *PokeAPIClient) doAssetRequest(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", assetAccept)
+	req.Header.Set("User-Agent", "golang-rest-api/1.0")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		c.logger.Debug("HTTP error",
+			zap.Int("status_code", resp.StatusCode),
+			zap.String("url", url),
+		)
+
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, domain.ErrResourceNotFound
+		}
+
+		return nil, &statusError{
+			StatusCode: resp.StatusCode,
+			Body:       http.StatusText(resp.StatusCode),
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	}
+
+	if resp.ContentLength > maxAssetBytes {
+		return nil, errAssetTooLarge
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxAssetBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if len(body) > maxAssetBytes {
+		return nil, errAssetTooLarge
+	}
+
+	return body, nil
+}
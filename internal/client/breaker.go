@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/polgarcia/golang-rest-api/internal/config"
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+)
+
+// errCircuitOpen is returned when the circuit breaker rejects a request
+var errCircuitOpen = errors.New("circuit breaker is open")
+
+// circuitBreaker stops calling the upstream after repeated failures.
+// After failureThreshold consecutive failures it opens and rejects requests
+// for cooldown; it then lets halfOpenRequests trial requests through and
+// closes again on success or reopens on failure.
+type circuitBreaker struct {
+	mu               sync.Mutex
+	state            domain.CircuitState
+	failures         int
+	openedAt         time.Time
+	inFlight         int
+	failureThreshold int
+	cooldown         time.Duration
+	halfOpenRequests int
+}
+
+// newCircuitBreaker creates a closed circuit breaker
+func newCircuitBreaker(cfg config.BreakerConfig) *circuitBreaker {
+	return &circuitBreaker{
+		state:            domain.CircuitClosed,
+		failureThreshold: cfg.FailureThreshold,
+		cooldown:         cfg.Cooldown,
+		halfOpenRequests: cfg.HalfOpenRequests,
+	}
+}
+
+// Allow reports whether a request may be sent to the upstream.
+// Every allowed request must be followed by a call to Record.
+func (b *circuitBreaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == domain.CircuitOpen {
+		if time.Since(b.openedAt) < b.cooldown {
+			return false
+		}
+		b.state = domain.CircuitHalfOpen
+		b.inFlight = 0
+	}
+
+	if b.state == domain.CircuitHalfOpen {
+		if b.inFlight >= b.halfOpenRequests {
+			return false
+		}
+		b.inFlight++
+	}
+
+	return true
+}
+
+// Record updates the breaker with the outcome of an allowed request
+func (b *circuitBreaker) Record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		// The caller gave up; this says nothing about the upstream
+		if b.state == domain.CircuitHalfOpen && b.inFlight > 0 {
+			b.inFlight--
+		}
+	case isUpstreamFailure(err):
+		b.failures++
+		if b.state == domain.CircuitHalfOpen || b.failures >= b.failureThreshold {
+			b.state = domain.CircuitOpen
+			b.openedAt = time.Now()
+		}
+	default:
+		b.state = domain.CircuitClosed
+		b.failures = 0
+		b.inFlight = 0
+	}
+}
+
+// State returns the current breaker state
+func (b *circuitBreaker) State() domain.CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == domain.CircuitOpen && time.Since(b.openedAt) >= b.cooldown {
+		return domain.CircuitHalfOpen
+	}
+
+	return b.state
+}
@@ -0,0 +1,654 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/polgarcia/golang-rest-api/internal/cache"
+	"github.com/polgarcia/golang-rest-api/internal/config"
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"github.com/polgarcia/golang-rest-api/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// backgroundRefreshTimeout bounds a stale-while-revalidate refresh
+const backgroundRefreshTimeout = 30 * time.Second
+
+// cacheEntry is the serialized form of a cached upstream result.
+// An alias entry only names the key the result is stored under.
+type cacheEntry struct {
+	Alias      string          `json:"alias,omitempty"`
+	NotFound   bool            `json:"not_found,omitempty"`
+	Resource   string          `json:"resource,omitempty"`
+	FreshUntil time.Time       `json:"fresh_until"`
+	Data       json.RawMessage `json:"data,omitempty"`
+}
+
+// freshness describes the age of a cache entry
+type freshness int
+
+const (
+	// entryFresh entries are served as-is
+	entryFresh freshness = iota
+
+	// entryRevalidate entries are served immediately and refreshed in the background
+	entryRevalidate
+
+	// entryStaleIfError entries are only served when refreshing them fails
+	entryStaleIfError
+)
+
+// CachedClient is a domain.PokemonClient decorator that caches upstream results.
+// Methods it does not override are passed straight through to the wrapped client.
+//
+// Entries are fresh for the configured TTL. For StaleWhileRevalidate after that
+// they are still served immediately while a background refresh runs, and for
+// StaleIfError they are served only if the upstream fails.
+type CachedClient struct {
+	domain.PokemonClient
+	store                cache.Store
+	ttl                  time.Duration
+	negativeTTL          time.Duration
+	staleWhileRevalidate time.Duration
+	staleIfError         time.Duration
+	refreshing           sync.Map
+	logger               *logger.Logger
+}
+
+// NewCachedClient wraps a PokemonClient with a TTL cache on the configured backend.
+// When caching is disabled in cfg every call goes straight to the wrapped client.
+func NewCachedClient(next domain.PokemonClient, cfg config.CacheConfig, log *logger.Logger) (*CachedClient, error) {
+	c := &CachedClient{
+		PokemonClient:        next,
+		ttl:                  cfg.TTL,
+		negativeTTL:          cfg.NegativeTTL,
+		staleWhileRevalidate: cfg.StaleWhileRevalidate,
+		staleIfError:         cfg.StaleIfError,
+		logger:               log,
+	}
+
+	if cfg.Enabled {
+		store, err := cache.NewStore(cfg.Backend, cfg.Dir, cfg.MaxEntries)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create cache store: %w", err)
+		}
+		c.store = store
+
+		log.Info("PokeAPI cache enabled",
+			zap.String("backend", cfg.Backend),
+			zap.Duration("ttl", cfg.TTL),
+		)
+	}
+
+	return c, nil
+}
+
+// FetchPokemon returns a cached Pokemon or fetches it from the wrapped client.
+// Successful results are stored under the ID with the name as an alias, so "25"
+// and "pikachu" resolve to the same entry once either has been fetched.
+func (c *CachedClient) FetchPokemon(ctx context.Context, nameOrID string) (*domain.Pokemon, error) {
+	return cachedFetchKeys(ctx, c, pokemonCacheKey(nameOrID),
+		func(ctx context.Context) (*domain.Pokemon, error) {
+			return c.PokemonClient.FetchPokemon(ctx, nameOrID)
+		},
+		func(pokemon *domain.Pokemon) []string {
+			return []string{
+				pokemonCacheKey(strconv.Itoa(pokemon.ID)),
+				pokemonCacheKey(pokemon.Name),
+			}
+		},
+	)
+}
+
+// FetchPokemonCount returns the cached Pokemon count or fetches it from the wrapped client
+func (c *CachedClient) FetchPokemonCount(ctx context.Context) (int, error) {
+	return cachedFetch(ctx, c, "pokemon-count", c.PokemonClient.FetchPokemonCount)
+}
+
+// FetchPokemonList returns a cached listing page or fetches it from the wrapped client
+func (c *CachedClient) FetchPokemonList(ctx context.Context, offset, limit int) ([]domain.NamedResource, int, error) {
+	return cachedList(ctx, c, fmt.Sprintf("pokemon-list:%d:%d", offset, limit),
+		func(ctx context.Context) ([]domain.NamedResource, int, error) {
+			return c.PokemonClient.FetchPokemonList(ctx, offset, limit)
+		},
+	)
+}
+
+// FetchResourceList returns a cached page of a resource listing or fetches it from the wrapped client
+func (c *CachedClient) FetchResourceList(ctx context.Context, endpoint string, offset, limit int) ([]domain.NamedResource, int, error) {
+	return cachedList(ctx, c, fmt.Sprintf("list:%s:%d:%d", endpoint, offset, limit),
+		func(ctx context.Context) ([]domain.NamedResource, int, error) {
+			return c.PokemonClient.FetchResourceList(ctx, endpoint, offset, limit)
+		},
+	)
+}
+
+// FetchType returns a cached type or fetches it from the wrapped client
+func (c *CachedClient) FetchType(ctx context.Context, name string) (*domain.TypeDetails, error) {
+	return cachedFetchKeys(ctx, c, typeCacheKey(name),
+		func(ctx context.Context) (*domain.TypeDetails, error) {
+			return c.PokemonClient.FetchType(ctx, name)
+		},
+		func(details *domain.TypeDetails) []string {
+			return []string{
+				typeCacheKey(strconv.Itoa(details.ID)),
+				typeCacheKey(details.Name),
+			}
+		},
+	)
+}
+
+// FetchAbility returns a cached ability or fetches it from the wrapped client
+func (c *CachedClient) FetchAbility(ctx context.Context, name string) (*domain.AbilityDetails, error) {
+	return cachedFetchKeys(ctx, c, abilityCacheKey(name),
+		func(ctx context.Context) (*domain.AbilityDetails, error) {
+			return c.PokemonClient.FetchAbility(ctx, name)
+		},
+		func(ability *domain.AbilityDetails) []string {
+			return []string{
+				abilityCacheKey(strconv.Itoa(ability.ID)),
+				abilityCacheKey(ability.Name),
+			}
+		},
+	)
+}
+
+// FetchMove returns a cached move or fetches it from the wrapped client
+func (c *CachedClient) FetchMove(ctx context.Context, name string) (*domain.MoveDetails, error) {
+	return cachedFetchKeys(ctx, c, moveCacheKey(name),
+		func(ctx context.Context) (*domain.MoveDetails, error) {
+			return c.PokemonClient.FetchMove(ctx, name)
+		},
+		func(move *domain.MoveDetails) []string {
+			return []string{
+				moveCacheKey(strconv.Itoa(move.ID)),
+				moveCacheKey(move.Name),
+			}
+		},
+	)
+}
+
+// FetchItem returns a cached item or fetches it from the wrapped client
+func (c *CachedClient) FetchItem(ctx context.Context, name string) (*domain.ItemDetails, error) {
+	return cachedFetchKeys(ctx, c, itemCacheKey(name),
+		func(ctx context.Context) (*domain.ItemDetails, error) {
+			return c.PokemonClient.FetchItem(ctx, name)
+		},
+		func(item *domain.ItemDetails) []string {
+			return []string{
+				itemCacheKey(strconv.Itoa(item.ID)),
+				itemCacheKey(item.Name),
+			}
+		},
+	)
+}
+
+// FetchBerry returns a cached berry or fetches it from the wrapped client
+func (c *CachedClient) FetchBerry(ctx context.Context, name string) (*domain.BerryDetails, error) {
+	return cachedFetchKeys(ctx, c, berryCacheKey(name),
+		func(ctx context.Context) (*domain.BerryDetails, error) {
+			return c.PokemonClient.FetchBerry(ctx, name)
+		},
+		func(berry *domain.BerryDetails) []string {
+			return []string{
+				berryCacheKey(strconv.Itoa(berry.ID)),
+				berryCacheKey(berry.Name),
+			}
+		},
+	)
+}
+
+// FetchEncounters returns cached encounters or fetches them from the wrapped client
+func (c *CachedClient) FetchEncounters(ctx context.Context, nameOrID string) ([]domain.LocationAreaEncounter, error) {
+	return cachedFetch(ctx, c, encountersCacheKey(nameOrID),
+		func(ctx context.Context) ([]domain.LocationAreaEncounter, error) {
+			return c.PokemonClient.FetchEncounters(ctx, nameOrID)
+		},
+	)
+}
+
+// FetchLocation returns a cached location or fetches it from the wrapped client
+func (c *CachedClient) FetchLocation(ctx context.Context, name string) (*domain.LocationDetails, error) {
+	return cachedFetchKeys(ctx, c, locationCacheKey(name),
+		func(ctx context.Context) (*domain.LocationDetails, error) {
+			return c.PokemonClient.FetchLocation(ctx, name)
+		},
+		func(location *domain.LocationDetails) []string {
+			return []string{
+				locationCacheKey(strconv.Itoa(location.ID)),
+				locationCacheKey(location.Name),
+			}
+		},
+	)
+}
+
+// FetchLocationArea returns a cached location area or fetches it from the wrapped client
+func (c *CachedClient) FetchLocationArea(ctx context.Context, name string) (*domain.LocationAreaDetails, error) {
+	return cachedFetchKeys(ctx, c, locationAreaCacheKey(name),
+		func(ctx context.Context) (*domain.LocationAreaDetails, error) {
+			return c.PokemonClient.FetchLocationArea(ctx, name)
+		},
+		func(area *domain.LocationAreaDetails) []string {
+			return []string{
+				locationAreaCacheKey(strconv.Itoa(area.ID)),
+				locationAreaCacheKey(area.Name),
+			}
+		},
+	)
+}
+
+// FetchGeneration returns a cached generation or fetches it from the wrapped client
+func (c *CachedClient) FetchGeneration(ctx context.Context, name string) (*domain.GenerationDetails, error) {
+	return cachedFetchKeys(ctx, c, generationCacheKey(name),
+		func(ctx context.Context) (*domain.GenerationDetails, error) {
+			return c.PokemonClient.FetchGeneration(ctx, name)
+		},
+		func(generation *domain.GenerationDetails) []string {
+			return []string{
+				generationCacheKey(strconv.Itoa(generation.ID)),
+				generationCacheKey(generation.Name),
+			}
+		},
+	)
+}
+
+// FetchRegion returns a cached region or fetches it from the wrapped client
+func (c *CachedClient) FetchRegion(ctx context.Context, name string) (*domain.RegionDetails, error) {
+	return cachedFetchKeys(ctx, c, regionCacheKey(name),
+		func(ctx context.Context) (*domain.RegionDetails, error) {
+			return c.PokemonClient.FetchRegion(ctx, name)
+		},
+		func(region *domain.RegionDetails) []string {
+			return []string{
+				regionCacheKey(strconv.Itoa(region.ID)),
+				regionCacheKey(region.Name),
+			}
+		},
+	)
+}
+
+// FetchPokedex returns a cached pokedex or fetches it from the wrapped client
+func (c *CachedClient) FetchPokedex(ctx context.Context, name string) (*domain.PokedexDetails, error) {
+	return cachedFetchKeys(ctx, c, pokedexCacheKey(name),
+		func(ctx context.Context) (*domain.PokedexDetails, error) {
+			return c.PokemonClient.FetchPokedex(ctx, name)
+		},
+		func(pokedex *domain.PokedexDetails) []string {
+			return []string{
+				pokedexCacheKey(strconv.Itoa(pokedex.ID)),
+				pokedexCacheKey(pokedex.Name),
+			}
+		},
+	)
+}
+
+// FetchForm returns a cached form or fetches it from the wrapped client
+func (c *CachedClient) FetchForm(ctx context.Context, name string) (*domain.FormDetails, error) {
+	return cachedFetchKeys(ctx, c, formCacheKey(name),
+		func(ctx context.Context) (*domain.FormDetails, error) {
+			return c.PokemonClient.FetchForm(ctx, name)
+		},
+		func(form *domain.FormDetails) []string {
+			return []string{
+				formCacheKey(strconv.Itoa(form.ID)),
+				formCacheKey(form.Name),
+			}
+		},
+	)
+}
+
+// FetchAsset returns a cached asset or downloads it with the wrapped client.
+// Assets are stored as raw bytes for the cache TTL rather than as JSON entries,
+// so they are never served stale.
+func (c *CachedClient) FetchAsset(ctx context.Context, url string) ([]byte, error) {
+	if c.store == nil {
+		return c.PokemonClient.FetchAsset(ctx, url)
+	}
+
+	info := domain.CacheInfoFrom(ctx)
+	key := assetCacheKey(url)
+
+	if data, ok := c.store.Get(key); ok {
+		c.logger.Debug("Cache hit", zap.String("key", key))
+		info.Record(domain.CacheHit)
+		return data, nil
+	}
+
+	data, err := c.PokemonClient.FetchAsset(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	info.Record(domain.CacheMiss)
+	c.store.Set(key, data, c.ttl)
+
+	return data, nil
+}
+
+// FetchSpecies returns a cached species or fetches it from the wrapped client
+func (c *CachedClient) FetchSpecies(ctx context.Context, nameOrID string) (*domain.Species, error) {
+	return cachedFetchKeys(ctx, c, speciesCacheKey(nameOrID),
+		func(ctx context.Context) (*domain.Species, error) {
+			return c.PokemonClient.FetchSpecies(ctx, nameOrID)
+		},
+		func(species *domain.Species) []string {
+			return []string{
+				speciesCacheKey(strconv.Itoa(species.ID)),
+				speciesCacheKey(species.Name),
+			}
+		},
+	)
+}
+
+// FetchEvolutionChain returns a cached evolution chain or fetches it from the wrapped client
+func (c *CachedClient) FetchEvolutionChain(ctx context.Context, id int) (*domain.EvolutionChain, error) {
+	return cachedFetch(ctx, c, evolutionCacheKey(id), func(ctx context.Context) (*domain.EvolutionChain, error) {
+		return c.PokemonClient.FetchEvolutionChain(ctx, id)
+	})
+}
+
+// cachedList is cachedFetch for listing pages, which carry a total alongside the items
+func cachedList(ctx context.Context, c *CachedClient, key string, fetch func(ctx context.Context) ([]domain.NamedResource, int, error)) ([]domain.NamedResource, int, error) {
+	type page struct {
+		Items []domain.NamedResource `json:"items"`
+		Total int                    `json:"total"`
+	}
+
+	result, err := cachedFetch(ctx, c, key, func(ctx context.Context) (page, error) {
+		items, total, err := fetch(ctx)
+		return page{Items: items, Total: total}, err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return result.Items, result.Total, nil
+}
+
+// cachedFetch returns the value cached under key, calling fetch and caching its result on a miss
+func cachedFetch[T any](ctx context.Context, c *CachedClient, key string, fetch func(ctx context.Context) (T, error)) (T, error) {
+	return cachedFetchKeys(ctx, c, key, fetch, nil)
+}
+
+// cachedFetchKeys is cachedFetch with extra keys derived from the fetched value.
+// A fetched value is stored once under the first key returned by keysFor, and
+// key and the other keys are stored as aliases of it.
+// Contexts marked with domain.WithoutCache go straight to the wrapped client.
+func cachedFetchKeys[T any](ctx context.Context, c *CachedClient, key string, fetch func(ctx context.Context) (T, error), keysFor func(T) []string) (T, error) {
+	if c.store == nil || domain.CacheBypassed(ctx) {
+		return fetch(ctx)
+	}
+
+	info := domain.CacheInfoFrom(ctx)
+
+	var cached T
+	found, age, cachedErr := c.lookup(key, &cached)
+	if found {
+		switch age {
+		case entryFresh:
+			info.Record(domain.CacheHit)
+			return cached, cachedErr
+		case entryRevalidate:
+			info.Record(domain.CacheStale)
+			refreshInBackground(c, key, fetch, keysFor)
+			return cached, cachedErr
+		}
+	}
+
+	value, err := fetch(ctx)
+	if err != nil {
+		// Serve stale data rather than failing when the upstream is down
+		if found && cachedErr == nil && errors.Is(err, domain.ErrExternalAPI) {
+			c.logger.Warn("Serving stale cache entry after upstream failure",
+				zap.String("key", key),
+				zap.Error(err),
+			)
+			info.RecordRevalidationFailed()
+			return cached, nil
+		}
+
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			c.storeNotFound(key, err)
+		}
+		return value, err
+	}
+
+	info.Record(domain.CacheMiss)
+	c.storeValue(value, storeKeys(key, value, keysFor)...)
+
+	return value, nil
+}
+
+// refreshInBackground refetches key unless a refresh for it is already running
+func refreshInBackground[T any](c *CachedClient, key string, fetch func(ctx context.Context) (T, error), keysFor func(T) []string) {
+	if _, running := c.refreshing.LoadOrStore(key, struct{}{}); running {
+		return
+	}
+
+	go func() {
+		defer c.refreshing.Delete(key)
+
+		ctx, cancel := context.WithTimeout(context.Background(), backgroundRefreshTimeout)
+		defer cancel()
+
+		value, err := fetch(ctx)
+		if err != nil {
+			c.logger.Warn("Background cache refresh failed", zap.String("key", key), zap.Error(err))
+			return
+		}
+
+		c.storeValue(value, storeKeys(key, value, keysFor)...)
+		c.logger.Debug("Background cache refresh completed", zap.String("key", key))
+	}()
+}
+
+// storeKeys returns the keys to store value under, the canonical one first:
+// the keys derived from value, followed by key
+func storeKeys[T any](key string, value T, keysFor func(T) []string) []string {
+	if keysFor == nil {
+		return []string{key}
+	}
+
+	return append(keysFor(value), key)
+}
+
+// lookup decodes the entry stored under key into dst, following an alias to its canonical entry.
+// It reports whether the key was found and how old the entry is;
+// a cached "not found" result is returned as an error.
+func (c *CachedClient) lookup(key string, dst interface{}) (bool, freshness, error) {
+	entry, ok := c.getEntry(key)
+	if ok && entry.Alias != "" {
+		key = entry.Alias
+		entry, ok = c.getEntry(key)
+	}
+	if !ok || entry.Alias != "" {
+		c.logger.Debug("Cache miss", zap.String("key", key))
+		return false, entryFresh, nil
+	}
+
+	if entry.NotFound {
+		c.logger.Debug("Cache hit (not found)", zap.String("key", key))
+		return true, entryFresh, domain.NotFound(entry.Resource)
+	}
+
+	if err := json.Unmarshal(entry.Data, dst); err != nil {
+		c.logger.Warn("Discarding corrupt cache entry", zap.String("key", key), zap.Error(err))
+		c.store.Delete(key)
+		return false, entryFresh, nil
+	}
+
+	now := time.Now()
+	switch {
+	case now.Before(entry.FreshUntil):
+		c.logger.Debug("Cache hit", zap.String("key", key))
+		return true, entryFresh, nil
+	case now.Before(entry.FreshUntil.Add(c.staleWhileRevalidate)):
+		c.logger.Debug("Cache hit (stale, revalidating)", zap.String("key", key))
+		return true, entryRevalidate, nil
+	case now.Before(entry.FreshUntil.Add(c.staleIfError)):
+		c.logger.Debug("Cache hit (stale)", zap.String("key", key))
+		return true, entryStaleIfError, nil
+	default:
+		// Kept only for the stale-while-revalidate window, which has passed
+		c.logger.Debug("Cache miss (expired)", zap.String("key", key))
+		return false, entryFresh, nil
+	}
+}
+
+// getEntry decodes the entry stored under key, discarding it if it is corrupt
+func (c *CachedClient) getEntry(key string) (cacheEntry, bool) {
+	var entry cacheEntry
+
+	raw, ok := c.store.Get(key)
+	if !ok {
+		return entry, false
+	}
+
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		c.logger.Warn("Discarding corrupt cache entry", zap.String("key", key), zap.Error(err))
+		c.store.Delete(key)
+		return entry, false
+	}
+
+	return entry, true
+}
+
+// storeValue caches value under the first of keys and stores the others as aliases of it.
+// Entries are kept past their TTL for as long as they may be served stale.
+func (c *CachedClient) storeValue(value interface{}, keys ...string) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		c.logger.Warn("Failed to encode cache entry", zap.Error(err))
+		return
+	}
+
+	raw, err := json.Marshal(cacheEntry{
+		FreshUntil: time.Now().Add(c.ttl),
+		Data:       data,
+	})
+	if err != nil {
+		c.logger.Warn("Failed to encode cache entry", zap.Error(err))
+		return
+	}
+
+	retention := c.ttl + c.staleWhileRevalidate
+	if staleIfError := c.ttl + c.staleIfError; staleIfError > retention {
+		retention = staleIfError
+	}
+
+	// The canonical entry is stored last, so a bounded store evicts an alias before it
+	canonical := keys[0]
+	alias, _ := json.Marshal(cacheEntry{Alias: canonical})
+	for _, key := range keys[1:] {
+		if key != canonical {
+			c.store.Set(key, alias, retention)
+		}
+	}
+	c.store.Set(canonical, raw, retention)
+}
+
+// storeNotFound caches a "not found" result under key, remembering which kind of resource was missing
+func (c *CachedClient) storeNotFound(key string, err error) {
+	if c.negativeTTL <= 0 {
+		return
+	}
+
+	entry := cacheEntry{
+		NotFound:   true,
+		FreshUntil: time.Now().Add(c.negativeTTL),
+	}
+
+	var notFound *domain.NotFoundError
+	if errors.As(err, &notFound) {
+		entry.Resource = notFound.Resource
+	}
+
+	raw, _ := json.Marshal(entry)
+	c.store.Set(key, raw, c.negativeTTL)
+}
+
+// pokemonCacheKey builds the cache key for a Pokemon name or ID
+func pokemonCacheKey(nameOrID string) string {
+	return "pokemon:" + strings.ToLower(strings.TrimSpace(nameOrID))
+}
+
+// speciesCacheKey builds the cache key for a species name or ID
+func speciesCacheKey(nameOrID string) string {
+	return "species:" + strings.ToLower(strings.TrimSpace(nameOrID))
+}
+
+// evolutionCacheKey builds the cache key for an evolution chain ID
+func evolutionCacheKey(id int) string {
+	return "evolution-chain:" + strconv.Itoa(id)
+}
+
+// typeCacheKey builds the cache key for a type name or ID
+func typeCacheKey(name string) string {
+	return "type:" + strings.ToLower(strings.TrimSpace(name))
+}
+
+// abilityCacheKey builds the cache key for an ability name or ID
+func abilityCacheKey(name string) string {
+	return "ability:" + strings.ToLower(strings.TrimSpace(name))
+}
+
+// moveCacheKey builds the cache key for a move name or ID
+func moveCacheKey(name string) string {
+	return "move:" + strings.ToLower(strings.TrimSpace(name))
+}
+
+// itemCacheKey builds the cache key for an item name or ID
+func itemCacheKey(name string) string {
+	return "item:" + strings.ToLower(strings.TrimSpace(name))
+}
+
+// berryCacheKey builds the cache key for a berry name or ID
+func berryCacheKey(name string) string {
+	return "berry:" + strings.ToLower(strings.TrimSpace(name))
+}
+
+// encountersCacheKey builds the cache key for the encounters of a Pokemon name or ID
+func encountersCacheKey(nameOrID string) string {
+	return "encounters:" + strings.ToLower(strings.TrimSpace(nameOrID))
+}
+
+// locationCacheKey builds the cache key for a location name or ID
+func locationCacheKey(name string) string {
+	return "location:" + strings.ToLower(strings.TrimSpace(name))
+}
+
+// locationAreaCacheKey builds the cache key for a location area name or ID
+func locationAreaCacheKey(name string) string {
+	return "location-area:" + strings.ToLower(strings.TrimSpace(name))
+}
+
+// generationCacheKey builds the cache key for a generation name or ID
+func generationCacheKey(name string) string {
+	return "generation:" + strings.ToLower(strings.TrimSpace(name))
+}
+
+// regionCacheKey builds the cache key for a region name or ID
+func regionCacheKey(name string) string {
+	return "region:" + strings.ToLower(strings.TrimSpace(name))
+}
+
+// pokedexCacheKey builds the cache key for a pokedex name or ID
+func pokedexCacheKey(name string) string {
+	return "pokedex:" + strings.ToLower(strings.TrimSpace(name))
+}
+
+// formCacheKey builds the cache key for a form name or ID
+func formCacheKey(name string) string {
+	return "form:" + strings.ToLower(strings.TrimSpace(name))
+}
+
+// assetCacheKey builds the cache key for an asset URL
+func assetCacheKey(url string) string {
+	return "asset:" + normalizeURL(url)
+}
@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// flightCall is an upstream request shared by every caller waiting on the same key
+type flightCall struct {
+	done      chan struct{}
+	body      []byte
+	err       error
+	waiters   int
+	deadline  time.Time
+	unbounded bool
+	cancel    context.CancelFunc
+}
+
+// join adds a waiter, extending the call's deadline to cover the waiter's; the caller must hold the lock
+func (c *flightCall) join(ctx context.Context) {
+	c.waiters++
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		c.unbounded = true
+	} else if deadline.After(c.deadline) {
+		c.deadline = deadline
+	}
+}
+
+// flightContext is the context a shared call runs on. Its deadline is the latest
+// deadline among the callers that joined the call, or none once a caller without
+// one has joined, so retry and rate limit budgets cover every waiting caller.
+// The call itself is only cancelled when every caller has given up.
+type flightContext struct {
+	context.Context
+	group *flightGroup
+	call  *flightCall
+}
+
+// Deadline reports the deadline of the waiting callers
+func (c flightContext) Deadline() (time.Time, bool) {
+	c.group.mu.Lock()
+	defer c.group.mu.Unlock()
+
+	if c.call.unbounded {
+		return time.Time{}, false
+	}
+	return c.call.deadline, true
+}
+
+// flightGroup deduplicates concurrent upstream requests for the same key
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+// Do runs fn once for all concurrent callers using the same key and hands each of
+// them the shared result. fn runs on a context detached from any single caller;
+// it is cancelled only once every waiting caller has given up, so one cancelled
+// request does not fail the others.
+func (g *flightGroup) Do(ctx context.Context, key string, fn func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall)
+	}
+
+	call, ok := g.calls[key]
+	if !ok {
+		callCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		call = &flightCall{
+			done:   make(chan struct{}),
+			cancel: cancel,
+		}
+		g.calls[key] = call
+
+		go func() {
+			call.body, call.err = fn(flightContext{Context: callCtx, group: g, call: call})
+			cancel()
+
+			g.mu.Lock()
+			g.forget(key, call)
+			g.mu.Unlock()
+
+			close(call.done)
+		}()
+	}
+	call.join(ctx)
+	g.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.body, call.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			// Nobody is left waiting: stop the upstream request and let the
+			// next caller start a fresh one instead of joining a cancelled call
+			call.cancel()
+			g.forget(key, call)
+		}
+		g.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+// forget removes call from the group if it is still the active call for key; the caller must hold the lock
+func (g *flightGroup) forget(key string, call *flightCall) {
+	if g.calls[key] == call {
+		delete(g.calls, key)
+	}
+}
+
+// normalizeURL returns a canonical form of rawURL used as the coalescing key
+func normalizeURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+
+	// Encode sorts query parameters by key
+	u.RawQuery = u.Query().Encode()
+	u.Fragment = ""
+
+	return u.String()
+}
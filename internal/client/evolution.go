@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// chainLink is a node of PokeAPI's evolution-chain resource
+type chainLink struct {
+	IsBaby           bool                 `json:"is_baby"`
+	Species          domain.NamedResource `json:"species"`
+	EvolutionDetails []evolutionDetail    `json:"evolution_details"`
+	EvolvesTo        []chainLink          `json:"evolves_to"`
+}
+
+// evolutionDetail is PokeAPI's description of one evolution method
+type evolutionDetail struct {
+	Trigger            domain.NamedResource  `json:"trigger"`
+	MinLevel           *int                  `json:"min_level"`
+	Item               *domain.NamedResource `json:"item"`
+	HeldItem           *domain.NamedResource `json:"held_item"`
+	MinHappiness       *int                  `json:"min_happiness"`
+	MinAffection       *int                  `json:"min_affection"`
+	MinBeauty          *int                  `json:"min_beauty"`
+	TimeOfDay          string                `json:"time_of_day"`
+	Gender             *int                  `json:"gender"`
+	KnownMove          *domain.NamedResource `json:"known_move"`
+	KnownMoveType      *domain.NamedResource `json:"known_move_type"`
+	Location           *domain.NamedResource `json:"location"`
+	PartySpecies       *domain.NamedResource `json:"party_species"`
+	TradeSpecies       *domain.NamedResource `json:"trade_species"`
+	NeedsOverworldRain bool                  `json:"needs_overworld_rain"`
+	TurnUpsideDown     bool                  `json:"turn_upside_down"`
+}
+
+// FetchEvolutionChain fetches an evolution chain by ID from the PokeAPI
+func (c *PokeAPIClient) FetchEvolutionChain(ctx context.Context, id int) (*domain.EvolutionChain, error) {
+	url := fmt.Sprintf("%s/evolution-chain/%d", c.baseURL, id)
+
+	c.logger.Debug("Fetching evolution chain",
+		zap.Int("id", id),
+		zap.String("url", url),
+	)
+
+	var result struct {
+		ID    int       `json:"id"`
+		Chain chainLink `json:"chain"`
+	}
+
+	if err := c.getJSON(ctx, url, &result); err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			c.logger.Debug("Evolution chain not found", zap.Int("id", id))
+			return nil, domain.NotFound("evolution chain")
+		}
+		c.logger.Error("Failed to fetch evolution chain",
+			zap.Int("id", id),
+			zap.Error(err),
+		)
+		return nil, externalError(err)
+	}
+
+	return &domain.EvolutionChain{
+		ID:   result.ID,
+		Root: result.Chain.toStage(),
+	}, nil
+}
+
+// toStage converts an upstream chain link and its descendants to domain stages
+func (l chainLink) toStage() domain.EvolutionStage {
+	stage := domain.EvolutionStage{
+		Species:    l.Species,
+		IsBaby:     l.IsBaby,
+		Conditions: make([]domain.EvolutionCondition, 0, len(l.EvolutionDetails)),
+		EvolvesTo:  make([]domain.EvolutionStage, 0, len(l.EvolvesTo)),
+	}
+
+	for _, detail := range l.EvolutionDetails {
+		stage.Conditions = append(stage.Conditions, detail.toCondition())
+	}
+	for _, next := range l.EvolvesTo {
+		stage.EvolvesTo = append(stage.EvolvesTo, next.toStage())
+	}
+
+	return stage
+}
+
+// toCondition converts an upstream evolution detail to a domain condition
+func (d evolutionDetail) toCondition() domain.EvolutionCondition {
+	return domain.EvolutionCondition{
+		Trigger:            d.Trigger.Name,
+		MinLevel:           d.MinLevel,
+		Item:               resourceName(d.Item),
+		HeldItem:           resourceName(d.HeldItem),
+		MinHappiness:       d.MinHappiness,
+		MinAffection:       d.MinAffection,
+		MinBeauty:          d.MinBeauty,
+		TimeOfDay:          d.TimeOfDay,
+		Gender:             genderName(d.Gender),
+		KnownMove:          resourceName(d.KnownMove),
+		KnownMoveType:      resourceName(d.KnownMoveType),
+		Location:           resourceName(d.Location),
+		PartySpecies:       resourceName(d.PartySpecies),
+		TradeSpecies:       resourceName(d.TradeSpecies),
+		NeedsOverworldRain: d.NeedsOverworldRain,
+		TurnUpsideDown:     d.TurnUpsideDown,
+	}
+}
+
+// resourceName returns the name of an optional resource reference
+func resourceName(resource *domain.NamedResource) string {
+	if resource == nil {
+		return ""
+	}
+	return resource.Name
+}
+
+// genderName converts PokeAPI's gender ID (1 female, 2 male) to a name
+func genderName(gender *int) string {
+	if gender == nil {
+		return ""
+	}
+
+	switch *gender {
+	case 1:
+		return "female"
+	case 2:
+		return "male"
+	default:
+		return fmt.Sprintf("gender-%d", *gender)
+	}
+}
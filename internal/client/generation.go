@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// FetchGeneration fetches a generation from the PokeAPI
+func (c *PokeAPIClient) FetchGeneration(ctx context.Context, name string) (*domain.GenerationDetails, error) {
+	url := fmt.Sprintf("%s/generation/%s", c.baseURL, strings.ToLower(name))
+
+	c.logger.Debug("Fetching generation",
+		zap.String("name", name),
+		zap.String("url", url),
+	)
+
+	var generation domain.GenerationDetails
+	if err := c.getJSON(ctx, url, &generation); err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			c.logger.Debug("Generation not found", zap.String("name", name))
+			return nil, domain.NotFound("generation")
+		}
+		c.logger.Error("Failed to fetch generation",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, externalError(err)
+	}
+
+	return &generation, nil
+}
+
+// FetchRegion fetches a region from the PokeAPI
+func (c *PokeAPIClient) FetchRegion(ctx context.Context, name string) (*domain.RegionDetails, error) {
+	url := fmt.Sprintf("%s/region/%s", c.baseURL, strings.ToLower(name))
+
+	c.logger.Debug("Fetching region",
+		zap.String("name", name),
+		zap.String("url", url),
+	)
+
+	var region domain.RegionDetails
+	if err := c.getJSON(ctx, url, &region); err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			c.logger.Debug("Region not found", zap.String("name", name))
+			return nil, domain.NotFound("region")
+		}
+		c.logger.Error("Failed to fetch region",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, externalError(err)
+	}
+
+	return &region, nil
+}
+
+// FetchPokedex fetches a pokedex from the PokeAPI
+func (c *PokeAPIClient) FetchPokedex(ctx context.Context, name string) (*domain.PokedexDetails, error) {
+	url := fmt.Sprintf("%s/pokedex/%s", c.baseURL, strings.ToLower(name))
+
+	c.logger.Debug("Fetching pokedex",
+		zap.String("name", name),
+		zap.String("url", url),
+	)
+
+	var pokedex domain.PokedexDetails
+	if err := c.getJSON(ctx, url, &pokedex); err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			c.logger.Debug("Pokedex not found", zap.String("name", name))
+			return nil, domain.NotFound("pokedex")
+		}
+		c.logger.Error("Failed to fetch pokedex",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, externalError(err)
+	}
+
+	return &pokedex, nil
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// FetchItem fetches an item from the PokeAPI
+func (c *PokeAPIClient) FetchItem(ctx context.Context, name string) (*domain.ItemDetails, error) {
+	url := fmt.Sprintf("%s/item/%s", c.baseURL, strings.ToLower(name))
+
+	c.logger.Debug("Fetching item",
+		zap.String("name", name),
+		zap.String("url", url),
+	)
+
+	var item domain.ItemDetails
+	if err := c.getJSON(ctx, url, &item); err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			c.logger.Debug("Item not found", zap.String("name", name))
+			return nil, domain.NotFound("item")
+		}
+		c.logger.Error("Failed to fetch item",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, externalError(err)
+	}
+
+	return &item, nil
+}
+
+// FetchBerry fetches a berry from the PokeAPI
+func (c *PokeAPIClient) FetchBerry(ctx context.Context, name string) (*domain.BerryDetails, error) {
+	url := fmt.Sprintf("%s/berry/%s", c.baseURL, strings.ToLower(name))
+
+	c.logger.Debug("Fetching berry",
+		zap.String("name", name),
+		zap.String("url", url),
+	)
+
+	var berry domain.BerryDetails
+	if err := c.getJSON(ctx, url, &berry); err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			c.logger.Debug("Berry not found", zap.String("name", name))
+			return nil, domain.NotFound("berry")
+		}
+		c.logger.Error("Failed to fetch berry",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, externalError(err)
+	}
+
+	return &berry, nil
+}
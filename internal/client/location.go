@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// FetchEncounters fetches the location areas a Pokemon can be encountered in from the PokeAPI
+func (c *PokeAPIClient) FetchEncounters(ctx context.Context, nameOrID string) ([]domain.LocationAreaEncounter, error) {
+	url := fmt.Sprintf("%s/pokemon/%s/encounters", c.baseURL, strings.ToLower(nameOrID))
+
+	c.logger.Debug("Fetching encounters",
+		zap.String("name_or_id", nameOrID),
+		zap.String("url", url),
+	)
+
+	var encounters []domain.LocationAreaEncounter
+	if err := c.getJSON(ctx, url, &encounters); err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			c.logger.Debug("Pokemon not found", zap.String("name_or_id", nameOrID))
+			return nil, domain.ErrPokemonNotFound
+		}
+		c.logger.Error("Failed to fetch encounters",
+			zap.String("name_or_id", nameOrID),
+			zap.Error(err),
+		)
+		return nil, externalError(err)
+	}
+
+	return encounters, nil
+}
+
+// FetchLocation fetches a location from the PokeAPI
+func (c *PokeAPIClient) FetchLocation(ctx context.Context, name string) (*domain.LocationDetails, error) {
+	url := fmt.Sprintf("%s/location/%s", c.baseURL, strings.ToLower(name))
+
+	c.logger.Debug("Fetching location",
+		zap.String("name", name),
+		zap.String("url", url),
+	)
+
+	var location domain.LocationDetails
+	if err := c.getJSON(ctx, url, &location); err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			c.logger.Debug("Location not found", zap.String("name", name))
+			return nil, domain.NotFound("location")
+		}
+		c.logger.Error("Failed to fetch location",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, externalError(err)
+	}
+
+	return &location, nil
+}
+
+// FetchLocationArea fetches a location area from the PokeAPI
+func (c *PokeAPIClient) FetchLocationArea(ctx context.Context, name string) (*domain.LocationAreaDetails, error) {
+	url := fmt.Sprintf("%s/location-area/%s", c.baseURL, strings.ToLower(name))
+
+	c.logger.Debug("Fetching location area",
+		zap.String("name", name),
+		zap.String("url", url),
+	)
+
+	var area domain.LocationAreaDetails
+	if err := c.getJSON(ctx, url, &area); err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			c.logger.Debug("Location area not found", zap.String("name", name))
+			return nil, domain.NotFound("location area")
+		}
+		c.logger.Error("Failed to fetch location area",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, externalError(err)
+	}
+
+	return &area, nil
+}
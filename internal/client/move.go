@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// FetchMove fetches a move from the PokeAPI
+func (c *PokeAPIClient) FetchMove(ctx context.Context, name string) (*domain.MoveDetails, error) {
+	url := fmt.Sprintf("%s/move/%s", c.baseURL, strings.ToLower(name))
+
+	c.logger.Debug("Fetching move",
+		zap.String("name", name),
+		zap.String("url", url),
+	)
+
+	var move domain.MoveDetails
+	if err := c.getJSON(ctx, url, &move); err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			c.logger.Debug("Move not found", zap.String("name", name))
+			return nil, domain.NotFound("move")
+		}
+		c.logger.Error("Failed to fetch move",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, externalError(err)
+	}
+
+	return &move, nil
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"time"
+
+	"github.com/polgarcia/golang-rest-api/internal/cache"
+	"github.com/polgarcia/golang-rest-api/internal/config"
+)
+
+// defaultBreakerConfig is used when no circuit breaker configuration is given
+var defaultBreakerConfig = config.BreakerConfig{
+	FailureThreshold: 5,
+	Cooldown:         30 * time.Second,
+	HalfOpenRequests: 1,
+}
+
+// backgroundRateShare is the fraction of the configured rate given to background requests
+const backgroundRateShare = 0.25
+
+// Option configures optional PokeAPIClient behaviour
+type Option func(*PokeAPIClient)
+
+// WithCircuitBreaker configures the circuit breakers guarding upstream requests.
+// JSON requests, asset downloads and background requests each get a breaker
+// with this configuration.
+func WithCircuitBreaker(cfg config.BreakerConfig) Option {
+	return func(c *PokeAPIClient) {
+		c.breaker = newCircuitBreaker(cfg)
+		c.assetBreaker = newCircuitBreaker(cfg)
+		c.bgBreaker = newCircuitBreaker(cfg)
+	}
+}
+
+// WithRetryPolicy configures how failed upstream requests are retried
+func WithRetryPolicy(cfg config.RetryConfig) Option {
+	return func(c *PokeAPIClient) {
+		c.retry = NewRetryPolicy(cfg)
+	}
+}
+
+// WithRateLimit throttles outbound requests with token buckets.
+// JSON requests and asset downloads each get a bucket with this configuration.
+// Background requests get a bucket of their own at a fraction of the rate, so
+// they never take tokens from user requests, and wait as long as their context allows.
+// A non-positive rate disables the limiters; a non-positive MaxWait uses the client timeout.
+func WithRateLimit(cfg config.RateLimitConfig) Option {
+	return func(c *PokeAPIClient) {
+		if cfg.RequestsPerSecond <= 0 {
+			c.limiter = nil
+			c.assetLimiter = nil
+			c.bgLimiter = nil
+			return
+		}
+		if cfg.MaxWait <= 0 {
+			cfg.MaxWait = c.httpClient.Timeout
+		}
+		c.limiter = newRateLimiter(cfg)
+		c.assetLimiter = newRateLimiter(cfg)
+		c.bgLimiter = newRateLimiter(config.RateLimitConfig{
+			RequestsPerSecond: cfg.RequestsPerSecond * backgroundRateShare,
+			Burst:             1,
+		})
+	}
+}
+
+// WithRevalidation keeps upstream response bodies and their ETag/Last-Modified
+// validators in store for ttl, so later requests for the same URL are sent as
+// conditional requests and a 304 Not Modified reuses the stored body
+func WithRevalidation(store cache.Store, ttl time.Duration) Option {
+	return func(c *PokeAPIClient) {
+		c.revalidator = &revalidator{store: store, ttl: ttl}
+	}
+}
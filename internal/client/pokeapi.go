@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -14,28 +15,49 @@ import (
 	"go.uber.org/zap"
 )
 
-const (
-	maxRetries     = 3
-	retryDelay     = 1 * time.Second
-	retryBackoff   = 2.0
-)
-
-// PokeAPIClient implements the PokemonClient interface
+// PokeAPIClient implements the PokemonClient interface.
+// Asset downloads have their own circuit breaker and rate limiter, so a
+// failing or busy asset host does not affect JSON requests and vice versa.
 type PokeAPIClient struct {
-	baseURL    string
-	httpClient *http.Client
-	logger     *logger.Logger
+	baseURL      string
+	httpClient   *http.Client
+	flights      flightGroup
+	breaker      *circuitBreaker
+	retry        RetryPolicy
+	limiter      *rateLimiter
+	revalidator  *revalidator
+	assetFlights flightGroup
+	assetBreaker *circuitBreaker
+	assetLimiter *rateLimiter
+	bgBreaker    *circuitBreaker
+	bgLimiter    *rateLimiter
+	logger       *logger.Logger
 }
 
 // NewPokeAPIClient creates a new PokeAPI client
-func NewPokeAPIClient(baseURL string, timeout time.Duration, log *logger.Logger) *PokeAPIClient {
-	return &PokeAPIClient{
+func NewPokeAPIClient(baseURL string, timeout time.Duration, log *logger.Logger, opts ...Option) *PokeAPIClient {
+	c := &PokeAPIClient{
 		baseURL: strings.TrimSuffix(baseURL, "/"),
 		httpClient: &http.Client{
 			Timeout: timeout,
 		},
-		logger: log,
+		breaker:      newCircuitBreaker(defaultBreakerConfig),
+		retry:        NewRetryPolicy(defaultRetryConfig),
+		assetBreaker: newCircuitBreaker(defaultBreakerConfig),
+		bgBreaker:    newCircuitBreaker(defaultBreakerConfig),
+		logger:       log,
+	}
+
+	for _, opt := range opts {
+		opt(c)
 	}
+
+	return c
+}
+
+// CircuitState returns the state of the circuit breaker guarding upstream requests
+func (c *PokeAPIClient) CircuitState() domain.CircuitState {
+	return c.breaker.State()
 }
 
 // FetchPokemon fetches a Pokemon from the PokeAPI
@@ -48,8 +70,8 @@ func (c *PokeAPIClient) FetchPokemon(ctx context.Context, nameOrID string) (*dom
 	)
 
 	var pokemon domain.Pokemon
-	if err := c.doRequestWithRetry(ctx, url, &pokemon); err != nil {
-		if err == domain.ErrPokemonNotFound {
+	if err := c.getJSON(ctx, url, &pokemon); err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
 			c.logger.Debug("Pokemon not found", zap.String("name_or_id", nameOrID))
 			return nil, domain.ErrPokemonNotFound
 		}
@@ -57,7 +79,7 @@ func (c *PokeAPIClient) FetchPokemon(ctx context.Context, nameOrID string) (*dom
 			zap.String("name_or_id", nameOrID),
 			zap.Error(err),
 		)
-		return nil, fmt.Errorf("%w: %v", domain.ErrExternalAPI, err)
+		return nil, externalError(err)
 	}
 
 	c.logger.Info("Successfully fetched Pokemon",
@@ -76,23 +98,146 @@ func (c *PokeAPIClient) FetchPokemonCount(ctx context.Context) (int, error) {
 		Count int `json:"count"`
 	}
 
-	if err := c.doRequestWithRetry(ctx, url, &result); err != nil {
-		return 0, fmt.Errorf("%w: %v", domain.ErrExternalAPI, err)
+	if err := c.getJSON(ctx, url, &result); err != nil {
+		return 0, externalError(err)
 	}
 
 	return result.Count, nil
 }
 
-// doRequestWithRetry performs an HTTP request with retry logic
-func (c *PokeAPIClient) doRequestWithRetry(ctx context.Context, url string, result interface{}) error {
+// FetchPokemonList fetches a slice of the Pokemon listing from the PokeAPI
+func (c *PokeAPIClient) FetchPokemonList(ctx context.Context, offset, limit int) ([]domain.NamedResource, int, error) {
+	return c.FetchResourceList(ctx, "pokemon", offset, limit)
+}
+
+// FetchResourceList fetches a slice of a named resource listing, such as "type", from the PokeAPI
+func (c *PokeAPIClient) FetchResourceList(ctx context.Context, endpoint string, offset, limit int) ([]domain.NamedResource, int, error) {
+	url := fmt.Sprintf("%s/%s?offset=%d&limit=%d", c.baseURL, endpoint, offset, limit)
+
+	c.logger.Debug("Fetching resource list",
+		zap.String("endpoint", endpoint),
+		zap.Int("offset", offset),
+		zap.Int("limit", limit),
+		zap.String("url", url),
+	)
+
+	var result struct {
+		Count   int                    `json:"count"`
+		Results []domain.NamedResource `json:"results"`
+	}
+
+	if err := c.getJSON(ctx, url, &result); err != nil {
+		c.logger.Error("Failed to fetch resource list",
+			zap.String("endpoint", endpoint),
+			zap.Int("offset", offset),
+			zap.Int("limit", limit),
+			zap.Error(err),
+		)
+		return nil, 0, externalError(err)
+	}
+
+	return result.Results, result.Count, nil
+}
+
+// FetchSpecies fetches a Pokemon species from the PokeAPI
+func (c *PokeAPIClient) FetchSpecies(ctx context.Context, nameOrID string) (*domain.Species, error) {
+	url := fmt.Sprintf("%s/pokemon-species/%s", c.baseURL, strings.ToLower(nameOrID))
+
+	c.logger.Debug("Fetching species",
+		zap.String("name_or_id", nameOrID),
+		zap.String("url", url),
+	)
+
+	var species domain.Species
+	if err := c.getJSON(ctx, url, &species); err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			c.logger.Debug("Species not found", zap.String("name_or_id", nameOrID))
+			return nil, domain.ErrPokemonNotFound
+		}
+		c.logger.Error("Failed to fetch species",
+			zap.String("name_or_id", nameOrID),
+			zap.Error(err),
+		)
+		return nil, externalError(err)
+	}
+
+	return &species, nil
+}
+
+// FetchForm fetches a Pokemon form from the PokeAPI
+func (c *PokeAPIClient) FetchForm(ctx context.Context, name string) (*domain.FormDetails, error) {
+	url := fmt.Sprintf("%s/pokemon-form/%s", c.baseURL, strings.ToLower(name))
+
+	c.logger.Debug("Fetching form",
+		zap.String("name", name),
+		zap.String("url", url),
+	)
+
+	var form domain.FormDetails
+	if err := c.getJSON(ctx, url, &form); err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			c.logger.Debug("Form not found", zap.String("name", name))
+			return nil, domain.NotFound("form")
+		}
+		c.logger.Error("Failed to fetch form",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, externalError(err)
+	}
+
+	return &form, nil
+}
+
+// getJSON fetches url and decodes the JSON response into result.
+// Concurrent calls for the same URL share a single upstream request.
+func (c *PokeAPIClient) getJSON(ctx context.Context, url string, result interface{}) error {
+	body, err := c.flights.Do(ctx, normalizeURL(url), func(ctx context.Context) ([]byte, error) {
+		breaker, limiter := c.breaker, c.limiter
+		if domain.IsBackground(ctx) {
+			breaker, limiter = c.bgBreaker, c.bgLimiter
+		}
+		return c.doRequestWithRetry(ctx, url, breaker, limiter, c.doRequest)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(body, result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+// doRequestWithRetry performs an HTTP request with do, retrying according to the retry policy.
+// Each attempt waits on limiter, if any, and is guarded by breaker.
+// Retries stop early when the next wait would run past the context deadline.
+func (c *PokeAPIClient) doRequestWithRetry(ctx context.Context, url string, breaker *circuitBreaker, limiter *rateLimiter, do func(ctx context.Context, url string) ([]byte, error)) ([]byte, error) {
 	var lastErr error
-	delay := retryDelay
 
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		if attempt > 0 {
+	// Without a caller deadline, all attempts share the client timeout
+	deadline, hasDeadline := ctx.Deadline()
+	if !hasDeadline && c.httpClient.Timeout > 0 {
+		deadline, hasDeadline = time.Now().Add(c.httpClient.Timeout), true
+	}
+
+	for attempt := 1; attempt <= c.retry.maxAttempts; attempt++ {
+		if attempt > 1 {
+			delay := c.retry.Delay(attempt-1, lastErr)
+
+			if hasDeadline && time.Now().Add(delay).After(deadline) {
+				c.logger.Warn("Retry budget exhausted",
+					zap.Int("attempt", attempt),
+					zap.Duration("delay", delay),
+					zap.Error(lastErr),
+				)
+				return nil, lastErr
+			}
+
 			c.logger.Debug("Retrying request",
-				zap.Int("attempt", attempt+1),
-				zap.Int("max_retries", maxRetries),
+				zap.Int("attempt", attempt),
+				zap.Int("max_attempts", c.retry.maxAttempts),
 				zap.Duration("delay", delay),
 			)
 
@@ -100,51 +245,75 @@ func (c *PokeAPIClient) doRequestWithRetry(ctx context.Context, url string, resu
 			select {
 			case <-time.After(delay):
 			case <-ctx.Done():
-				return ctx.Err()
+				return nil, ctx.Err()
 			}
-
-			// Exponential backoff
-			delay = time.Duration(float64(delay) * retryBackoff)
 		}
 
-		err := c.doRequest(ctx, url, result)
-		if err == nil {
-			return nil
+		if limiter != nil {
+			if err := limiter.Wait(ctx); err != nil {
+				c.logger.Warn("Outbound rate limit exceeded", zap.String("url", url), zap.Error(err))
+				return nil, err
+			}
 		}
 
-		// Don't retry on 404
-		if err == domain.ErrPokemonNotFound {
-			return err
+		if !breaker.Allow() {
+			c.logger.Warn("Circuit breaker open, failing fast", zap.String("url", url))
+			return nil, errCircuitOpen
 		}
 
-		lastErr = err
+		body, err := do(ctx, url)
+		breaker.Record(err)
+		if err == nil {
+			return body, nil
+		}
 
 		// Don't retry on context cancellation
 		if ctx.Err() != nil {
-			return ctx.Err()
+			return nil, ctx.Err()
+		}
+
+		if !c.retry.Retryable(err) {
+			return nil, err
 		}
+
+		lastErr = err
 	}
 
 	c.logger.Warn("Max retries exceeded", zap.Error(lastErr))
-	return lastErr
+	return nil, lastErr
 }
 
-// doRequest performs a single HTTP GET request
-func (c *PokeAPIClient) doRequest(ctx context.Context, url string, result interface{}) error {
+// doRequest performs a single HTTP GET request and returns the response body
+func (c *PokeAPIClient) doRequest(ctx context.Context, url string) ([]byte, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", "golang-rest-api/1.0")
 
+	// Revalidate a previously stored response instead of downloading it again
+	var stored *storedResponse
+	if c.revalidator != nil {
+		stored = c.revalidator.load(url)
+		if stored != nil {
+			stored.setConditionalHeaders(req)
+		}
+	}
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotModified && stored != nil {
+		c.logger.Debug("Upstream response not modified", zap.String("url", url))
+		c.revalidator.put(url, stored)
+		return stored.Body, nil
+	}
+
 	// Handle HTTP errors
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
@@ -154,16 +323,61 @@ func (c *PokeAPIClient) doRequest(ctx context.Context, url string, result interf
 		)
 
 		if resp.StatusCode == http.StatusNotFound {
-			return domain.ErrPokemonNotFound
+			return nil, domain.ErrResourceNotFound
 		}
 
-		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
+		return nil, &statusError{
+			StatusCode: resp.StatusCode,
+			Body:       string(body),
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
 	}
 
-	// Decode response
-	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
-		return fmt.Errorf("failed to decode response: %w", err)
+	// Read response
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
-	return nil
+	if c.revalidator != nil && !domain.CacheBypassed(ctx) {
+		c.revalidator.save(url, body, resp.Header)
+	}
+
+	return body, nil
+}
+
+// statusError is returned when the upstream answers with an unexpected HTTP status
+type statusError struct {
+	StatusCode int
+	Body       string
+	RetryAfter time.Duration
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("HTTP %d: %s", e.StatusCode, e.Body)
+}
+
+// isUpstreamFailure reports whether err means the upstream is unhealthy:
+// network errors, timeouts, 429 and 5xx responses
+func isUpstreamFailure(err error) bool {
+	if err == nil || errors.Is(err, domain.ErrResourceNotFound) || errors.Is(err, errAssetTooLarge) {
+		return false
+	}
+
+	var statusErr *statusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests || statusErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	return true
+}
+
+// externalError wraps an upstream failure as domain.ErrExternalAPI.
+// Rate limit errors are passed through so callers can tell clients when to retry.
+func externalError(err error) error {
+	if errors.Is(err, domain.ErrRateLimited) {
+		return err
+	}
+
+	return fmt.Errorf("%w: %v", domain.ErrExternalAPI, err)
 }
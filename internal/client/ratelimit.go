@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/polgarcia/golang-rest-api/internal/config"
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+)
+
+// rateLimiter is a token bucket limiting outbound requests.
+// Tokens refill at rate per second up to burst.
+type rateLimiter struct {
+	mu      sync.Mutex
+	rate    float64
+	burst   float64
+	tokens  float64
+	last    time.Time
+	maxWait time.Duration
+}
+
+// newRateLimiter creates a token bucket that starts full.
+// A non-positive cfg.MaxWait bounds waits by the context deadline only.
+func newRateLimiter(cfg config.RateLimitConfig) *rateLimiter {
+	return &rateLimiter{
+		rate:    cfg.RequestsPerSecond,
+		burst:   float64(cfg.Burst),
+		tokens:  float64(cfg.Burst),
+		last:    time.Now(),
+		maxWait: cfg.MaxWait,
+	}
+}
+
+// Wait blocks until a token is available or ctx is done.
+// If the wait would exceed the maximum wait or run past the context deadline
+// it returns a *domain.RateLimitError immediately instead of waiting.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	wait := l.reserve()
+	if wait == 0 {
+		return nil
+	}
+
+	if l.maxWait > 0 && wait > l.maxWait {
+		l.release()
+		return &domain.RateLimitError{RetryAfter: wait}
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+		l.release()
+		return &domain.RateLimitError{RetryAfter: wait}
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.release()
+		return ctx.Err()
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before using it
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// release returns a reserved token that was not used
+func (l *rateLimiter) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens++
+}
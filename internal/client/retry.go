@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/polgarcia/golang-rest-api/internal/config"
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+)
+
+// defaultRetryConfig is used when no retry configuration is given
+var defaultRetryConfig = config.RetryConfig{
+	MaxAttempts: 3,
+	BaseDelay:   1 * time.Second,
+	MaxDelay:    10 * time.Second,
+	Jitter:      0.2,
+}
+
+// RetryPolicy decides whether and when a failed upstream request is retried
+type RetryPolicy struct {
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+	jitter      float64
+}
+
+// NewRetryPolicy creates a retry policy from configuration
+func NewRetryPolicy(cfg config.RetryConfig) RetryPolicy {
+	return RetryPolicy{
+		maxAttempts: cfg.MaxAttempts,
+		baseDelay:   cfg.BaseDelay,
+		maxDelay:    cfg.MaxDelay,
+		jitter:      cfg.Jitter,
+	}
+}
+
+// Retryable reports whether err is worth retrying: network errors, 429 and 5xx.
+// Client errors, not-found results and an open circuit are returned immediately,
+// as is an upstream that asks to wait longer than the maximum delay.
+func (p RetryPolicy) Retryable(err error) bool {
+	if errors.Is(err, errCircuitOpen) || errors.Is(err, domain.ErrRateLimited) || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var statusErr *statusError
+	if errors.As(err, &statusErr) && statusErr.RetryAfter > p.maxDelay {
+		return false
+	}
+
+	return isUpstreamFailure(err)
+}
+
+// Delay returns how long to wait before the given retry (1 for the first retry).
+// An upstream Retry-After hint takes precedence when it asks for a longer wait,
+// up to the maximum delay.
+func (p RetryPolicy) Delay(retry int, err error) time.Duration {
+	delay := time.Duration(float64(p.baseDelay) * math.Pow(2, float64(retry-1)))
+	if delay > p.maxDelay || delay <= 0 {
+		delay = p.maxDelay
+	}
+
+	if p.jitter > 0 {
+		delay -= time.Duration(rand.Float64() * p.jitter * float64(delay))
+	}
+
+	var statusErr *statusError
+	if errors.As(err, &statusErr) && statusErr.RetryAfter > delay {
+		delay = min(statusErr.RetryAfter, p.maxDelay)
+	}
+
+	return delay
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(at); wait > 0 {
+			return wait
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/polgarcia/golang-rest-api/internal/cache"
+)
+
+// storedResponse is an upstream response body kept with its validators
+type storedResponse struct {
+	Body         []byte `json:"body"`
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+}
+
+// revalidator remembers upstream validators so refreshes can use conditional requests
+type revalidator struct {
+	store cache.Store
+	ttl   time.Duration
+}
+
+// load returns the stored response for url, if any
+func (v *revalidator) load(url string) *storedResponse {
+	raw, ok := v.store.Get(validatorKey(url))
+	if !ok {
+		return nil
+	}
+
+	var stored storedResponse
+	if err := json.Unmarshal(raw, &stored); err != nil {
+		return nil
+	}
+
+	return &stored
+}
+
+// save stores body with the validators from header; responses without validators are skipped
+func (v *revalidator) save(url string, body []byte, header http.Header) {
+	stored := storedResponse{
+		Body:         body,
+		ETag:         header.Get("ETag"),
+		LastModified: header.Get("Last-Modified"),
+	}
+	if stored.ETag == "" && stored.LastModified == "" {
+		return
+	}
+
+	v.put(url, &stored)
+}
+
+// put writes a stored response, resetting its TTL
+func (v *revalidator) put(url string, stored *storedResponse) {
+	raw, err := json.Marshal(stored)
+	if err != nil {
+		return
+	}
+
+	v.store.Set(validatorKey(url), raw, v.ttl)
+}
+
+// setConditionalHeaders adds If-None-Match/If-Modified-Since for a stored response
+func (s *storedResponse) setConditionalHeaders(req *http.Request) {
+	if s.ETag != "" {
+		req.Header.Set("If-None-Match", s.ETag)
+	}
+	if s.LastModified != "" {
+		req.Header.Set("If-Modified-Since", s.LastModified)
+	}
+}
+
+// validatorKey builds the store key for an upstream URL
+func validatorKey(url string) string {
+	return "http:" + normalizeURL(url)
+}
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// FetchType fetches a type and its damage relations from the PokeAPI
+func (c *PokeAPIClient) FetchType(ctx context.Context, name string) (*domain.TypeDetails, error) {
+	url := fmt.Sprintf("%s/type/%s", c.baseURL, strings.ToLower(name))
+
+	c.logger.Debug("Fetching type",
+		zap.String("name", name),
+		zap.String("url", url),
+	)
+
+	var details domain.TypeDetails
+	if err := c.getJSON(ctx, url, &details); err != nil {
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			c.logger.Debug("Type not found", zap.String("name", name))
+			return nil, domain.NotFound("type")
+		}
+		c.logger.Error("Failed to fetch type",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, externalError(err)
+	}
+
+	return &details, nil
+}
@@ -10,10 +10,11 @@ import (
 
 // Config holds all application configuration
 type Config struct {
-	Server   ServerConfig
-	PokeAPI  PokeAPIConfig
-	Logging  LoggingConfig
-	CORS     CORSConfig
+	Server    ServerConfig
+	PokeAPI   PokeAPIConfig
+	Logging   LoggingConfig
+	CORS      CORSConfig
+	HTTPCache HTTPCacheConfig
 }
 
 // ServerConfig holds HTTP server configuration
@@ -26,8 +27,48 @@ type ServerConfig struct {
 
 // PokeAPIConfig holds PokeAPI client configuration
 type PokeAPIConfig struct {
-	BaseURL string
-	Timeout time.Duration
+	BaseURL   string
+	Timeout   time.Duration
+	Cache     CacheConfig
+	Breaker   BreakerConfig
+	Retry     RetryConfig
+	RateLimit RateLimitConfig
+}
+
+// CacheConfig holds PokeAPI response cache configuration
+type CacheConfig struct {
+	Enabled              bool
+	Backend              string
+	Dir                  string
+	TTL                  time.Duration
+	NegativeTTL          time.Duration
+	StaleWhileRevalidate time.Duration
+	StaleIfError         time.Duration
+	RevalidateTTL        time.Duration
+	MaxEntries           int
+}
+
+// BreakerConfig holds PokeAPI circuit breaker configuration
+type BreakerConfig struct {
+	FailureThreshold int
+	Cooldown         time.Duration
+	HalfOpenRequests int
+}
+
+// RetryConfig holds PokeAPI retry policy configuration
+type RetryConfig struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	Jitter      float64
+}
+
+// RateLimitConfig holds PokeAPI outbound rate limit configuration
+type RateLimitConfig struct {
+	RequestsPerSecond float64
+	Burst             int
+	// MaxWait is the longest a request waits for a token; 0 uses the client timeout
+	MaxWait time.Duration
 }
 
 // LoggingConfig holds logging configuration
@@ -41,6 +82,19 @@ type CORSConfig struct {
 	AllowedOrigins string
 }
 
+// HTTPCacheConfig holds the Cache-Control max-age advertised by our own endpoints
+type HTTPCacheConfig struct {
+	PokemonMaxAge time.Duration
+	ListMaxAge    time.Duration
+	CountMaxAge   time.Duration
+
+	// ReferenceMaxAge applies to reference data such as species that rarely changes
+	ReferenceMaxAge time.Duration
+
+	// AssetMaxAge applies to proxied binary assets such as cries and sprites
+	AssetMaxAge time.Duration
+}
+
 // Load loads configuration from environment variables and .env file
 func Load() (*Config, error) {
 	// Load .env file if it exists (optional, won't fail if not found)
@@ -63,6 +117,33 @@ func Load() (*Config, error) {
 		PokeAPI: PokeAPIConfig{
 			BaseURL: viper.GetString("POKEAPI_BASE_URL"),
 			Timeout: viper.GetDuration("POKEAPI_TIMEOUT"),
+			Cache: CacheConfig{
+				Enabled:              viper.GetBool("POKEAPI_CACHE_ENABLED"),
+				Backend:              viper.GetString("POKEAPI_CACHE_BACKEND"),
+				Dir:                  viper.GetString("POKEAPI_CACHE_DIR"),
+				TTL:                  viper.GetDuration("POKEAPI_CACHE_TTL"),
+				NegativeTTL:          viper.GetDuration("POKEAPI_CACHE_NEGATIVE_TTL"),
+				StaleWhileRevalidate: viper.GetDuration("POKEAPI_CACHE_STALE_WHILE_REVALIDATE"),
+				StaleIfError:         viper.GetDuration("POKEAPI_CACHE_STALE_IF_ERROR"),
+				MaxEntries:           viper.GetInt("POKEAPI_CACHE_MAX_ENTRIES"),
+				RevalidateTTL:        viper.GetDuration("POKEAPI_CACHE_REVALIDATE_TTL"),
+			},
+			Breaker: BreakerConfig{
+				FailureThreshold: viper.GetInt("POKEAPI_BREAKER_FAILURE_THRESHOLD"),
+				Cooldown:         viper.GetDuration("POKEAPI_BREAKER_COOLDOWN"),
+				HalfOpenRequests: viper.GetInt("POKEAPI_BREAKER_HALF_OPEN_REQUESTS"),
+			},
+			Retry: RetryConfig{
+				MaxAttempts: viper.GetInt("POKEAPI_RETRY_MAX_ATTEMPTS"),
+				BaseDelay:   viper.GetDuration("POKEAPI_RETRY_BASE_DELAY"),
+				MaxDelay:    viper.GetDuration("POKEAPI_RETRY_MAX_DELAY"),
+				Jitter:      viper.GetFloat64("POKEAPI_RETRY_JITTER"),
+			},
+			RateLimit: RateLimitConfig{
+				RequestsPerSecond: viper.GetFloat64("POKEAPI_RATE_LIMIT_RPS"),
+				Burst:             viper.GetInt("POKEAPI_RATE_LIMIT_BURST"),
+				MaxWait:           viper.GetDuration("POKEAPI_RATE_LIMIT_MAX_WAIT"),
+			},
 		},
 		Logging: LoggingConfig{
 			Level:  viper.GetString("LOG_LEVEL"),
@@ -71,6 +152,13 @@ func Load() (*Config, error) {
 		CORS: CORSConfig{
 			AllowedOrigins: viper.GetString("CORS_ALLOWED_ORIGINS"),
 		},
+		HTTPCache: HTTPCacheConfig{
+			PokemonMaxAge:   viper.GetDuration("HTTP_CACHE_POKEMON_MAX_AGE"),
+			ListMaxAge:      viper.GetDuration("HTTP_CACHE_LIST_MAX_AGE"),
+			CountMaxAge:     viper.GetDuration("HTTP_CACHE_COUNT_MAX_AGE"),
+			ReferenceMaxAge: viper.GetDuration("HTTP_CACHE_REFERENCE_MAX_AGE"),
+			AssetMaxAge:     viper.GetDuration("HTTP_CACHE_ASSET_MAX_AGE"),
+		},
 	}
 
 	// Validate configuration
@@ -92,6 +180,25 @@ func setDefaults() {
 	// PokeAPI defaults
 	viper.SetDefault("POKEAPI_BASE_URL", "https://pokeapi.co/api/v2")
 	viper.SetDefault("POKEAPI_TIMEOUT", "30s")
+	viper.SetDefault("POKEAPI_CACHE_ENABLED", true)
+	viper.SetDefault("POKEAPI_CACHE_BACKEND", "memory")
+	viper.SetDefault("POKEAPI_CACHE_DIR", ".cache/pokeapi")
+	viper.SetDefault("POKEAPI_CACHE_TTL", "1h")
+	viper.SetDefault("POKEAPI_CACHE_NEGATIVE_TTL", "5m")
+	viper.SetDefault("POKEAPI_CACHE_STALE_WHILE_REVALIDATE", "5m")
+	viper.SetDefault("POKEAPI_CACHE_STALE_IF_ERROR", "24h")
+	viper.SetDefault("POKEAPI_CACHE_MAX_ENTRIES", 1000)
+	viper.SetDefault("POKEAPI_CACHE_REVALIDATE_TTL", "168h")
+	viper.SetDefault("POKEAPI_BREAKER_FAILURE_THRESHOLD", 5)
+	viper.SetDefault("POKEAPI_BREAKER_COOLDOWN", "30s")
+	viper.SetDefault("POKEAPI_BREAKER_HALF_OPEN_REQUESTS", 1)
+	viper.SetDefault("POKEAPI_RETRY_MAX_ATTEMPTS", 3)
+	viper.SetDefault("POKEAPI_RETRY_BASE_DELAY", "1s")
+	viper.SetDefault("POKEAPI_RETRY_MAX_DELAY", "10s")
+	viper.SetDefault("POKEAPI_RETRY_JITTER", 0.2)
+	viper.SetDefault("POKEAPI_RATE_LIMIT_RPS", 10)
+	viper.SetDefault("POKEAPI_RATE_LIMIT_BURST", 20)
+	viper.SetDefault("POKEAPI_RATE_LIMIT_MAX_WAIT", "0s")
 
 	// Logging defaults
 	viper.SetDefault("LOG_LEVEL", "info")
@@ -99,6 +206,13 @@ func setDefaults() {
 
 	// CORS defaults
 	viper.SetDefault("CORS_ALLOWED_ORIGINS", "*")
+
+	// HTTP response cache defaults
+	viper.SetDefault("HTTP_CACHE_POKEMON_MAX_AGE", "1h")
+	viper.SetDefault("HTTP_CACHE_LIST_MAX_AGE", "10m")
+	viper.SetDefault("HTTP_CACHE_COUNT_MAX_AGE", "10m")
+	viper.SetDefault("HTTP_CACHE_REFERENCE_MAX_AGE", "24h")
+	viper.SetDefault("HTTP_CACHE_ASSET_MAX_AGE", "720h")
 }
 
 // validate validates the configuration
@@ -111,6 +225,64 @@ func (c *Config) validate() error {
 		return fmt.Errorf("POKEAPI_BASE_URL is required")
 	}
 
+	if c.PokeAPI.Cache.Enabled {
+		switch c.PokeAPI.Cache.Backend {
+		case "memory":
+		case "file":
+			if c.PokeAPI.Cache.Dir == "" {
+				return fmt.Errorf("POKEAPI_CACHE_DIR is required for the file cache backend")
+			}
+		default:
+			return fmt.Errorf("invalid POKEAPI_CACHE_BACKEND: must be one of memory, file")
+		}
+		if c.PokeAPI.Cache.TTL <= 0 {
+			return fmt.Errorf("POKEAPI_CACHE_TTL must be greater than 0")
+		}
+		if c.PokeAPI.Cache.NegativeTTL < 0 {
+			return fmt.Errorf("POKEAPI_CACHE_NEGATIVE_TTL must not be negative")
+		}
+		if c.PokeAPI.Cache.MaxEntries <= 0 {
+			return fmt.Errorf("POKEAPI_CACHE_MAX_ENTRIES must be greater than 0")
+		}
+		if c.PokeAPI.Cache.StaleWhileRevalidate < 0 || c.PokeAPI.Cache.StaleIfError < 0 {
+			return fmt.Errorf("POKEAPI_CACHE_STALE_WHILE_REVALIDATE and POKEAPI_CACHE_STALE_IF_ERROR must not be negative")
+		}
+		if c.PokeAPI.Cache.RevalidateTTL < 0 {
+			return fmt.Errorf("POKEAPI_CACHE_REVALIDATE_TTL must not be negative")
+		}
+	}
+
+	if c.PokeAPI.Breaker.FailureThreshold <= 0 {
+		return fmt.Errorf("POKEAPI_BREAKER_FAILURE_THRESHOLD must be greater than 0")
+	}
+	if c.PokeAPI.Breaker.Cooldown <= 0 {
+		return fmt.Errorf("POKEAPI_BREAKER_COOLDOWN must be greater than 0")
+	}
+	if c.PokeAPI.Breaker.HalfOpenRequests <= 0 {
+		return fmt.Errorf("POKEAPI_BREAKER_HALF_OPEN_REQUESTS must be greater than 0")
+	}
+
+	if c.PokeAPI.Retry.MaxAttempts <= 0 {
+		return fmt.Errorf("POKEAPI_RETRY_MAX_ATTEMPTS must be greater than 0")
+	}
+	if c.PokeAPI.Retry.BaseDelay <= 0 {
+		return fmt.Errorf("POKEAPI_RETRY_BASE_DELAY must be greater than 0")
+	}
+	if c.PokeAPI.Retry.MaxDelay < c.PokeAPI.Retry.BaseDelay {
+		return fmt.Errorf("POKEAPI_RETRY_MAX_DELAY must not be less than POKEAPI_RETRY_BASE_DELAY")
+	}
+	if c.PokeAPI.Retry.Jitter < 0 || c.PokeAPI.Retry.Jitter > 1 {
+		return fmt.Errorf("POKEAPI_RETRY_JITTER must be between 0 and 1")
+	}
+
+	if c.PokeAPI.RateLimit.RequestsPerSecond > 0 && c.PokeAPI.RateLimit.Burst <= 0 {
+		return fmt.Errorf("POKEAPI_RATE_LIMIT_BURST must be greater than 0")
+	}
+
+	if c.PokeAPI.RateLimit.MaxWait < 0 {
+		return fmt.Errorf("POKEAPI_RATE_LIMIT_MAX_WAIT must not be negative")
+	}
+
 	if c.Logging.Level == "" {
 		return fmt.Errorf("LOG_LEVEL is required")
 	}
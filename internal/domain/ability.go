@@ -0,0 +1,29 @@
+package domain
+
+// AbilityDetails represents an ability as returned by the PokeAPI
+type AbilityDetails struct {
+	ID            int              `json:"id"`
+	Name          string           `json:"name"`
+	IsMainSeries  bool             `json:"is_main_series"`
+	Generation    NamedResource    `json:"generation"`
+	EffectEntries []EffectEntry    `json:"effect_entries"`
+	Pokemon       []AbilityPokemon `json:"pokemon"`
+	Names         []Name           `json:"names"`
+}
+
+// AbilityPokemon represents a Pokemon that can have an ability
+type AbilityPokemon struct {
+	IsHidden bool          `json:"is_hidden"`
+	Slot     int           `json:"slot"`
+	Pokemon  NamedResource `json:"pokemon"`
+}
+
+// AbilitySummary represents an ability with its name and effect localized
+type AbilitySummary struct {
+	ID            int              `json:"id"`
+	Name          string           `json:"name"`
+	LocalizedName *LocalizedName   `json:"localized_name"`
+	Generation    string           `json:"generation"`
+	Effect        EffectText       `json:"effect"`
+	Pokemon       []AbilityPokemon `json:"pokemon"`
+}
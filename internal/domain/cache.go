@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"context"
+	"sync"
+)
+
+// CacheStatus describes how cached data was used to serve a request
+type CacheStatus string
+
+const (
+	// CacheHit means the data was served fresh from the cache
+	CacheHit CacheStatus = "HIT"
+
+	// CacheMiss means the data was fetched from the external API
+	CacheMiss CacheStatus = "MISS"
+
+	// CacheStale means stale data was served from the cache
+	CacheStale CacheStatus = "STALE"
+)
+
+// CacheInfo collects the cache status of every lookup made while serving a request
+type CacheInfo struct {
+	mu                 sync.Mutex
+	status             CacheStatus
+	revalidationFailed bool
+}
+
+type cacheInfoKey struct{}
+
+// WithCacheInfo returns a context that records cache usage into the returned CacheInfo
+func WithCacheInfo(ctx context.Context) (context.Context, *CacheInfo) {
+	info := &CacheInfo{}
+	return context.WithValue(ctx, cacheInfoKey{}, info), info
+}
+
+// CacheInfoFrom returns the CacheInfo attached to ctx, or nil
+func CacheInfoFrom(ctx context.Context) *CacheInfo {
+	info, _ := ctx.Value(cacheInfoKey{}).(*CacheInfo)
+	return info
+}
+
+type bypassCacheKey struct{}
+
+// WithoutCache returns a context whose lookups skip the response cache, so a bulk
+// fetch of data that is only read once does not evict everything else
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheKey{}, true)
+}
+
+// CacheBypassed reports whether lookups made with ctx should skip the response cache
+func CacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassCacheKey{}).(bool)
+	return bypass
+}
+
+// Record notes the status of a cache lookup. When a request makes several
+// lookups the least fresh status wins: STALE over MISS over HIT.
+func (i *CacheInfo) Record(status CacheStatus) {
+	if i == nil {
+		return
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if cacheStatusRank(status) > cacheStatusRank(i.status) {
+		i.status = status
+	}
+}
+
+// RecordRevalidationFailed notes that stale data was served because refreshing it failed
+func (i *CacheInfo) RecordRevalidationFailed() {
+	if i == nil {
+		return
+	}
+
+	i.Record(CacheStale)
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.revalidationFailed = true
+}
+
+// Status returns the recorded cache status, or "" if no lookup was recorded
+func (i *CacheInfo) Status() CacheStatus {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.status
+}
+
+// RevalidationFailed reports whether stale data was served after a failed refresh
+func (i *CacheInfo) RevalidationFailed() bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.revalidationFailed
+}
+
+// cacheStatusRank orders statuses from freshest to stalest
+func cacheStatusRank(status CacheStatus) int {
+	switch status {
+	case CacheHit:
+		return 1
+	case CacheMiss:
+		return 2
+	case CacheStale:
+		return 3
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,52 @@
+package domain
+
+// PokemonComparison represents several Pokemon side by side.
+// Every slice aligned with Pokemon holds one entry per Pokemon, in request order,
+// and deltas and ratios are relative to the first Pokemon.
+type PokemonComparison struct {
+	Pokemon    []string         `json:"pokemon"`
+	Stats      []StatComparison `json:"stats"`
+	Types      TraitComparison  `json:"types"`
+	Abilities  TraitComparison  `json:"abilities"`
+	Size       SizeComparison   `json:"size"`
+	HeadToHead []TypeHeadToHead `json:"head_to_head"`
+}
+
+// StatComparison represents one base stat, or the base stat total, of every compared Pokemon
+type StatComparison struct {
+	Name    string   `json:"name"`
+	Values  []int    `json:"values"`
+	Deltas  []int    `json:"deltas"`
+	Highest []string `json:"highest"`
+}
+
+// TraitComparison represents the types or abilities that all compared Pokemon share,
+// and those each one is alone in having
+type TraitComparison struct {
+	Shared []string   `json:"shared"`
+	Unique [][]string `json:"unique"`
+}
+
+// SizeComparison represents the height in decimetres and weight in hectograms of every compared Pokemon
+type SizeComparison struct {
+	Heights      []int     `json:"heights"`
+	Weights      []int     `json:"weights"`
+	HeightRatios []float64 `json:"height_ratios"`
+	WeightRatios []float64 `json:"weight_ratios"`
+}
+
+// TypeHeadToHead represents how hard two Pokemon hit each other with moves of their own types.
+// Advantage names the Pokemon that hits harder and is omitted when they are even.
+type TypeHeadToHead struct {
+	First           string     `json:"first"`
+	Second          string     `json:"second"`
+	FirstAttacking  TypeAttack `json:"first_attacking"`
+	SecondAttacking TypeAttack `json:"second_attacking"`
+	Advantage       string     `json:"advantage,omitempty"`
+}
+
+// TypeAttack represents the most effective of an attacker's types against a defender
+type TypeAttack struct {
+	Type       string  `json:"type"`
+	Multiplier float64 `json:"multiplier"`
+}
@@ -0,0 +1,31 @@
+package domain
+
+// EffectEntry represents a localized effect description
+type EffectEntry struct {
+	Effect      string        `json:"effect"`
+	ShortEffect string        `json:"short_effect"`
+	Language    NamedResource `json:"language"`
+}
+
+// EffectText represents the effect of an ability or move in one language
+type EffectText struct {
+	Language    string `json:"language"`
+	ShortEffect string `json:"short_effect"`
+	Effect      string `json:"effect"`
+}
+
+// LocalizeEffect returns the entry in the most preferred of languages.
+// Effect text is only translated into a few languages, so callers should end
+// languages with one every effect has.
+func LocalizeEffect(entries []EffectEntry, languages []string) EffectText {
+	found := Localize(entries, func(entry *EffectEntry) string { return entry.Language.Name }, languages)
+	if found == nil {
+		return EffectText{}
+	}
+
+	return EffectText{
+		Language:    found.Language.Name,
+		ShortEffect: found.ShortEffect,
+		Effect:      found.Effect,
+	}
+}
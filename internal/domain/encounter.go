@@ -0,0 +1,95 @@
+package domain
+
+// LocationAreaEncounter represents where a Pokemon can be encountered, as returned by the PokeAPI
+type LocationAreaEncounter struct {
+	LocationArea   NamedResource            `json:"location_area"`
+	VersionDetails []VersionEncounterDetail `json:"version_details"`
+}
+
+// VersionEncounterDetail represents the encounters in one game version
+type VersionEncounterDetail struct {
+	Version          NamedResource     `json:"version"`
+	MaxChance        int               `json:"max_chance"`
+	EncounterDetails []EncounterDetail `json:"encounter_details"`
+}
+
+// EncounterDetail represents one way of encountering a Pokemon
+type EncounterDetail struct {
+	MinLevel        int             `json:"min_level"`
+	MaxLevel        int             `json:"max_level"`
+	Chance          int             `json:"chance"`
+	Method          NamedResource   `json:"method"`
+	ConditionValues []NamedResource `json:"condition_values"`
+}
+
+// LocationDetails represents a location as returned by the PokeAPI
+type LocationDetails struct {
+	ID     int             `json:"id"`
+	Name   string          `json:"name"`
+	Region *NamedResource  `json:"region"`
+	Areas  []NamedResource `json:"areas"`
+}
+
+// LocationAreaDetails represents a location area and the Pokemon found there, as returned by the PokeAPI
+type LocationAreaDetails struct {
+	ID                int                    `json:"id"`
+	Name              string                 `json:"name"`
+	PokemonEncounters []AreaPokemonEncounter `json:"pokemon_encounters"`
+}
+
+// AreaPokemonEncounter represents a Pokemon found in a location area
+type AreaPokemonEncounter struct {
+	Pokemon        NamedResource            `json:"pokemon"`
+	VersionDetails []VersionEncounterDetail `json:"version_details"`
+}
+
+// PokemonEncounters represents where a Pokemon can be caught, grouped by game version
+type PokemonEncounters struct {
+	Pokemon  string              `json:"pokemon"`
+	Versions []VersionEncounters `json:"versions"`
+}
+
+// VersionEncounters represents the location areas a Pokemon is found in within one version
+type VersionEncounters struct {
+	Version string           `json:"version"`
+	Areas   []AreaEncounters `json:"areas"`
+}
+
+// AreaEncounters represents the ways a Pokemon is encountered in one location area
+type AreaEncounters struct {
+	LocationArea string      `json:"location_area"`
+	MaxChance    int         `json:"max_chance"`
+	Encounters   []Encounter `json:"encounters"`
+}
+
+// Encounter represents one way of encountering a Pokemon
+type Encounter struct {
+	Method     string   `json:"method"`
+	MinLevel   int      `json:"min_level"`
+	MaxLevel   int      `json:"max_level"`
+	Chance     int      `json:"chance"`
+	Conditions []string `json:"conditions"`
+}
+
+// LocationSummary represents a location and the Pokemon found in each of its areas
+type LocationSummary struct {
+	ID      int                   `json:"id"`
+	Name    string                `json:"name"`
+	Region  string                `json:"region,omitempty"`
+	Areas   []LocationAreaPokemon `json:"areas"`
+	Pokemon []string              `json:"pokemon"`
+}
+
+// LocationAreaPokemon represents the Pokemon found in one location area
+type LocationAreaPokemon struct {
+	Name    string        `json:"name"`
+	Pokemon []AreaPokemon `json:"pokemon"`
+}
+
+// AreaPokemon represents a Pokemon found in a location area, across all versions
+type AreaPokemon struct {
+	Name     string   `json:"name"`
+	Versions []string `json:"versions"`
+	MinLevel int      `json:"min_level"`
+	MaxLevel int      `json:"max_level"`
+}
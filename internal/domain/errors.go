@@ -1,10 +1,17 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 var (
+	// ErrResourceNotFound is returned when any requested resource does not exist
+	ErrResourceNotFound = errors.New("resource not found")
+
 	// ErrPokemonNotFound is returned when a Pokemon is not found
-	ErrPokemonNotFound = errors.New("pokemon not found")
+	ErrPokemonNotFound error = &NotFoundError{Resource: "pokemon"}
 
 	// ErrInvalidInput is returned when input validation fails
 	ErrInvalidInput = errors.New("invalid input")
@@ -12,4 +19,64 @@ var (
 	// ErrExternalAPI is returned when the external API fails
 	ErrExternalAPI = errors.New("external API error")
 
+	// ErrRateLimited is returned when the outbound rate limit would be exceeded
+	ErrRateLimited = errors.New("rate limit exceeded")
+
+	// ErrNotReady is returned when data built in the background is not available yet
+	ErrNotReady = errors.New("not ready")
 )
+
+// RateLimitError is returned when a request cannot be sent within its deadline
+// because of the outbound rate limit
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("%s: retry after %s", ErrRateLimited, e.RetryAfter)
+}
+
+// Unwrap allows errors.Is(err, ErrRateLimited)
+func (e *RateLimitError) Unwrap() error {
+	return ErrRateLimited
+}
+
+// NotReadyError is returned when a resource built in the background, such as the
+// stat distribution, is not available yet, with how long to wait before retrying
+type NotReadyError struct {
+	Resource   string
+	RetryAfter time.Duration
+}
+
+func (e *NotReadyError) Error() string {
+	return fmt.Sprintf("%s %s: retry after %s", e.Resource, ErrNotReady, e.RetryAfter)
+}
+
+// Unwrap allows errors.Is(err, ErrNotReady)
+func (e *NotReadyError) Unwrap() error {
+	return ErrNotReady
+}
+
+// NotFoundError is returned when a resource of a given kind does not exist
+type NotFoundError struct {
+	Resource string
+}
+
+func (e *NotFoundError) Error() string {
+	return e.Resource + " not found"
+}
+
+// Unwrap allows errors.Is(err, ErrResourceNotFound)
+func (e *NotFoundError) Unwrap() error {
+	return ErrResourceNotFound
+}
+
+// NotFound returns the not found error for a kind of resource.
+// Pokemon, and entries cached before the kind was recorded, map to
+// ErrPokemonNotFound so it can still be compared directly.
+func NotFound(resource string) error {
+	if resource == "" || resource == "pokemon" {
+		return ErrPokemonNotFound
+	}
+	return &NotFoundError{Resource: resource}
+}
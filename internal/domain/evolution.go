@@ -0,0 +1,36 @@
+package domain
+
+// EvolutionChain represents a resolved evolution family
+type EvolutionChain struct {
+	ID   int            `json:"id"`
+	Root EvolutionStage `json:"root"`
+}
+
+// EvolutionStage represents one species in an evolution chain and the species it evolves into
+type EvolutionStage struct {
+	Species    NamedResource        `json:"species"`
+	IsBaby     bool                 `json:"is_baby"`
+	Conditions []EvolutionCondition `json:"conditions"`
+	EvolvesTo  []EvolutionStage     `json:"evolves_to"`
+}
+
+// EvolutionCondition describes one way of evolving into a stage.
+// Unset fields do not apply; a stage reachable in several ways has several conditions.
+type EvolutionCondition struct {
+	Trigger            string `json:"trigger"`
+	MinLevel           *int   `json:"min_level,omitempty"`
+	Item               string `json:"item,omitempty"`
+	HeldItem           string `json:"held_item,omitempty"`
+	MinHappiness       *int   `json:"min_happiness,omitempty"`
+	MinAffection       *int   `json:"min_affection,omitempty"`
+	MinBeauty          *int   `json:"min_beauty,omitempty"`
+	TimeOfDay          string `json:"time_of_day,omitempty"`
+	Gender             string `json:"gender,omitempty"`
+	KnownMove          string `json:"known_move,omitempty"`
+	KnownMoveType      string `json:"known_move_type,omitempty"`
+	Location           string `json:"location,omitempty"`
+	PartySpecies       string `json:"party_species,omitempty"`
+	TradeSpecies       string `json:"trade_species,omitempty"`
+	NeedsOverworldRain bool   `json:"needs_overworld_rain,omitempty"`
+	TurnUpsideDown     bool   `json:"turn_upside_down,omitempty"`
+}
@@ -0,0 +1,68 @@
+package domain
+
+// SpeciesVariety represents a Pokemon that belongs to a species, such as a regional form or a mega evolution
+type SpeciesVariety struct {
+	IsDefault bool          `json:"is_default"`
+	Pokemon   NamedResource `json:"pokemon"`
+}
+
+// FormDetails represents a Pokemon form as returned by the PokeAPI
+type FormDetails struct {
+	ID           int           `json:"id"`
+	Name         string        `json:"name"`
+	FormName     string        `json:"form_name"`
+	IsDefault    bool          `json:"is_default"`
+	IsBattleOnly bool          `json:"is_battle_only"`
+	IsMega       bool          `json:"is_mega"`
+	Pokemon      NamedResource `json:"pokemon"`
+	Types        []PokemonType `json:"types"`
+	VersionGroup NamedResource `json:"version_group"`
+}
+
+// FormKind classifies a variety of a species
+type FormKind string
+
+// Form kinds
+const (
+	FormDefault    FormKind = "default"
+	FormRegional   FormKind = "regional"
+	FormMega       FormKind = "mega"
+	FormGigantamax FormKind = "gigantamax"
+	FormOther      FormKind = "other"
+)
+
+// PokemonForms represents every variety of a species and the forms of each
+type PokemonForms struct {
+	Species   string    `json:"species"`
+	Varieties []Variety `json:"varieties"`
+}
+
+// Variety represents a Pokemon belonging to a species, with its own types and stats
+type Variety struct {
+	Name      string         `json:"name"`
+	IsDefault bool           `json:"is_default"`
+	Kind      FormKind       `json:"kind"`
+	Types     []string       `json:"types"`
+	Stats     map[string]int `json:"stats"`
+	Forms     []Form         `json:"forms"`
+}
+
+// Form represents a cosmetic or battle form of a variety
+type Form struct {
+	Name         string   `json:"name"`
+	FormName     string   `json:"form_name"`
+	IsDefault    bool     `json:"is_default"`
+	IsBattleOnly bool     `json:"is_battle_only"`
+	IsMega       bool     `json:"is_mega"`
+	VersionGroup string   `json:"version_group"`
+	Types        []string `json:"types"`
+}
+
+// AlternateForm represents another variety of a Pokemon's species, listed on the Pokemon itself
+type AlternateForm struct {
+	Name  string         `json:"name"`
+	URL   string         `json:"url"`
+	Kind  FormKind       `json:"kind"`
+	Types []string       `json:"types"`
+	Stats map[string]int `json:"stats"`
+}
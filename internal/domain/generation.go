@@ -0,0 +1,91 @@
+package domain
+
+// GenerationDetails represents a generation as returned by the PokeAPI
+type GenerationDetails struct {
+	ID             int             `json:"id"`
+	Name           string          `json:"name"`
+	MainRegion     NamedResource   `json:"main_region"`
+	PokemonSpecies []NamedResource `json:"pokemon_species"`
+	Types          []NamedResource `json:"types"`
+	Moves          []NamedResource `json:"moves"`
+	Abilities      []NamedResource `json:"abilities"`
+	VersionGroups  []NamedResource `json:"version_groups"`
+}
+
+// RegionDetails represents a region as returned by the PokeAPI
+type RegionDetails struct {
+	ID             int             `json:"id"`
+	Name           string          `json:"name"`
+	MainGeneration *NamedResource  `json:"main_generation"`
+	Locations      []NamedResource `json:"locations"`
+	Pokedexes      []NamedResource `json:"pokedexes"`
+	VersionGroups  []NamedResource `json:"version_groups"`
+}
+
+// PokedexDetails represents a Pokedex as returned by the PokeAPI
+type PokedexDetails struct {
+	ID             int             `json:"id"`
+	Name           string          `json:"name"`
+	IsMainSeries   bool            `json:"is_main_series"`
+	Region         *NamedResource  `json:"region"`
+	PokemonEntries []PokedexEntry  `json:"pokemon_entries"`
+	VersionGroups  []NamedResource `json:"version_groups"`
+}
+
+// PokedexEntry represents a species and its number in a Pokedex
+type PokedexEntry struct {
+	EntryNumber    int           `json:"entry_number"`
+	PokemonSpecies NamedResource `json:"pokemon_species"`
+}
+
+// GenerationSummary represents a generation and what it introduced.
+// Species are ordered by National Pokedex number.
+type GenerationSummary struct {
+	ID            int             `json:"id"`
+	Name          string          `json:"name"`
+	MainRegion    string          `json:"main_region"`
+	VersionGroups []string        `json:"version_groups"`
+	Species       []NamedResource `json:"species"`
+	Types         []string        `json:"types"`
+	Moves         []string        `json:"moves"`
+	Abilities     []string        `json:"abilities"`
+}
+
+// RegionSummary represents a region with its Pokedexes, locations and games
+type RegionSummary struct {
+	ID             int      `json:"id"`
+	Name           string   `json:"name"`
+	MainGeneration string   `json:"main_generation,omitempty"`
+	Pokedexes      []string `json:"pokedexes"`
+	VersionGroups  []string `json:"version_groups"`
+	Locations      []string `json:"locations"`
+}
+
+// Pokedex represents a single page of a Pokedex's entries
+type Pokedex struct {
+	ID            int                   `json:"id"`
+	Name          string                `json:"name"`
+	Region        string                `json:"region,omitempty"`
+	IsMainSeries  bool                  `json:"is_main_series"`
+	VersionGroups []string              `json:"version_groups"`
+	Entries       []PokedexEntrySummary `json:"entries"`
+	Total         int                   `json:"total"`
+	Page          int                   `json:"page"`
+	PageSize      int                   `json:"page_size"`
+}
+
+// HasNext reports whether there is a page of entries after this one
+func (p *Pokedex) HasNext() bool {
+	return p.Page*p.PageSize < p.Total
+}
+
+// HasPrev reports whether there is a page of entries before this one
+func (p *Pokedex) HasPrev() bool {
+	return p.Page > 1
+}
+
+// PokedexEntrySummary represents a species and its number in a Pokedex
+type PokedexEntrySummary struct {
+	EntryNumber int    `json:"entry_number"`
+	Species     string `json:"species"`
+}
@@ -0,0 +1,21 @@
+package domain
+
+// CircuitState represents the state of a circuit breaker
+type CircuitState string
+
+const (
+	// CircuitClosed means requests flow normally
+	CircuitClosed CircuitState = "closed"
+
+	// CircuitOpen means requests fail fast without reaching the upstream
+	CircuitOpen CircuitState = "open"
+
+	// CircuitHalfOpen means a limited number of trial requests are allowed through
+	CircuitHalfOpen CircuitState = "half-open"
+)
+
+// UpstreamMonitor reports the health of the external API connection
+type UpstreamMonitor interface {
+	// CircuitState returns the current circuit breaker state
+	CircuitState() CircuitState
+}
@@ -0,0 +1,90 @@
+package domain
+
+// HeldItem represents an item a wild Pokemon may hold, with its rarity per game version
+type HeldItem struct {
+	Item           NamedResource    `json:"item"`
+	VersionDetails []HeldItemRarity `json:"version_details"`
+}
+
+// HeldItemRarity represents the percent chance of a held item in one game version
+type HeldItemRarity struct {
+	Rarity  int           `json:"rarity"`
+	Version NamedResource `json:"version"`
+}
+
+// ItemHolder represents a Pokemon that may hold an item, with its rarity per game version
+type ItemHolder struct {
+	Pokemon        NamedResource    `json:"pokemon"`
+	VersionDetails []HeldItemRarity `json:"version_details"`
+}
+
+// ItemSprites represents item sprite images
+type ItemSprites struct {
+	Default string `json:"default"`
+}
+
+// ItemDetails represents an item as returned by the PokeAPI
+type ItemDetails struct {
+	ID            int             `json:"id"`
+	Name          string          `json:"name"`
+	Cost          int             `json:"cost"`
+	FlingPower    *int            `json:"fling_power"`
+	FlingEffect   *NamedResource  `json:"fling_effect"`
+	Category      NamedResource   `json:"category"`
+	Attributes    []NamedResource `json:"attributes"`
+	EffectEntries []EffectEntry   `json:"effect_entries"`
+	Sprites       ItemSprites     `json:"sprites"`
+	HeldByPokemon []ItemHolder    `json:"held_by_pokemon"`
+}
+
+// ItemSummary represents an item with its effect localized
+type ItemSummary struct {
+	ID            int          `json:"id"`
+	Name          string       `json:"name"`
+	Cost          int          `json:"cost"`
+	Category      string       `json:"category"`
+	Attributes    []string     `json:"attributes"`
+	FlingPower    *int         `json:"fling_power"`
+	FlingEffect   string       `json:"fling_effect,omitempty"`
+	Sprite        string       `json:"sprite"`
+	Effect        EffectText   `json:"effect"`
+	HeldByPokemon []ItemHolder `json:"held_by_pokemon"`
+}
+
+// BerryDetails represents a berry as returned by the PokeAPI
+type BerryDetails struct {
+	ID               int           `json:"id"`
+	Name             string        `json:"name"`
+	GrowthTime       int           `json:"growth_time"`
+	MaxHarvest       int           `json:"max_harvest"`
+	NaturalGiftPower int           `json:"natural_gift_power"`
+	NaturalGiftType  NamedResource `json:"natural_gift_type"`
+	Size             int           `json:"size"`
+	Smoothness       int           `json:"smoothness"`
+	SoilDryness      int           `json:"soil_dryness"`
+	Firmness         NamedResource `json:"firmness"`
+	Flavors          []BerryFlavor `json:"flavors"`
+	Item             NamedResource `json:"item"`
+}
+
+// BerryFlavor represents how strongly a berry has a flavor
+type BerryFlavor struct {
+	Potency int           `json:"potency"`
+	Flavor  NamedResource `json:"flavor"`
+}
+
+// BerrySummary represents a berry together with the item it is
+type BerrySummary struct {
+	ID               int            `json:"id"`
+	Name             string         `json:"name"`
+	Firmness         string         `json:"firmness"`
+	GrowthTime       int            `json:"growth_time"`
+	MaxHarvest       int            `json:"max_harvest"`
+	Size             int            `json:"size"`
+	Smoothness       int            `json:"smoothness"`
+	SoilDryness      int            `json:"soil_dryness"`
+	NaturalGiftPower int            `json:"natural_gift_power"`
+	NaturalGiftType  string         `json:"natural_gift_type"`
+	Flavors          map[string]int `json:"flavors"`
+	Item             *ItemSummary   `json:"item"`
+}
@@ -0,0 +1,60 @@
+package domain
+
+import "strings"
+
+// DefaultLanguage is the language resource names are given in
+const DefaultLanguage = "en"
+
+// Name represents a localized name of a PokeAPI resource
+type Name struct {
+	Name     string        `json:"name"`
+	Language NamedResource `json:"language"`
+}
+
+// LocalizedName represents the name of a resource in the language it was resolved to
+type LocalizedName struct {
+	Name     string `json:"name"`
+	Language string `json:"language"`
+}
+
+// Localize returns the entry in the most preferred of languages, or nil if
+// none of them has one. Languages are compared case-insensitively, and a
+// language without a script or region matches one with it, so "ja" matches
+// "ja-Hrkt" when there is no plain "ja" entry.
+func Localize[T any](entries []T, languageOf func(*T) string, languages []string) *T {
+	for _, language := range languages {
+		var variant *T
+		for i := range entries {
+			name := languageOf(&entries[i])
+			if strings.EqualFold(name, language) {
+				return &entries[i]
+			}
+			if variant == nil && isVariantOf(name, language) {
+				variant = &entries[i]
+			}
+		}
+		if variant != nil {
+			return variant
+		}
+	}
+	return nil
+}
+
+// LocalizeName returns the name in the most preferred of languages, or nil if
+// the resource has not been named in any of them
+func LocalizeName(names []Name, languages []string) *LocalizedName {
+	found := Localize(names, func(name *Name) string { return name.Language.Name }, languages)
+	if found == nil {
+		return nil
+	}
+
+	return &LocalizedName{
+		Name:     found.Name,
+		Language: found.Language.Name,
+	}
+}
+
+// isVariantOf reports whether tag is language with a script or region subtag, e.g. "zh-Hant" of "zh"
+func isVariantOf(tag, language string) bool {
+	return len(tag) > len(language) && tag[len(language)] == '-' && strings.EqualFold(tag[:len(language)], language)
+}
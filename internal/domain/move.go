@@ -0,0 +1,81 @@
+package domain
+
+// PokemonMove represents a move a Pokemon can learn and how it learns it in each version group
+type PokemonMove struct {
+	Move                MoveInfo          `json:"move"`
+	VersionGroupDetails []MoveLearnDetail `json:"version_group_details"`
+}
+
+// MoveInfo represents move information.
+// LocalizedName is only set when a language is requested.
+type MoveInfo struct {
+	Name          string         `json:"name"`
+	URL           string         `json:"url"`
+	LocalizedName *LocalizedName `json:"localized_name,omitempty"`
+}
+
+// MoveLearnDetail represents how a move is learned in one version group
+type MoveLearnDetail struct {
+	LevelLearnedAt  int           `json:"level_learned_at"`
+	MoveLearnMethod NamedResource `json:"move_learn_method"`
+	VersionGroup    NamedResource `json:"version_group"`
+}
+
+// LearnableMove represents one way a Pokemon learns a move.
+// LocalizedName is only set when a language is requested.
+type LearnableMove struct {
+	Name          string         `json:"name"`
+	LocalizedName *LocalizedName `json:"localized_name,omitempty"`
+	Method        string         `json:"method"`
+	Level         int            `json:"level"`
+	VersionGroup  string         `json:"version_group"`
+}
+
+// PokemonMoves represents the filtered moveset of a Pokemon
+type PokemonMoves struct {
+	Pokemon      string          `json:"pokemon"`
+	VersionGroup string          `json:"version_group,omitempty"`
+	Method       string          `json:"method,omitempty"`
+	Moves        []LearnableMove `json:"moves"`
+}
+
+// MoveFilter selects which learnable moves are returned
+type MoveFilter struct {
+	VersionGroup string
+	Method       string
+}
+
+// MoveDetails represents a move as returned by the PokeAPI
+type MoveDetails struct {
+	ID            int           `json:"id"`
+	Name          string        `json:"name"`
+	Accuracy      *int          `json:"accuracy"`
+	Power         *int          `json:"power"`
+	PP            *int          `json:"pp"`
+	Priority      int           `json:"priority"`
+	EffectChance  *int          `json:"effect_chance"`
+	DamageClass   NamedResource `json:"damage_class"`
+	Type          NamedResource `json:"type"`
+	Target        NamedResource `json:"target"`
+	Generation    NamedResource `json:"generation"`
+	EffectEntries []EffectEntry `json:"effect_entries"`
+	Names         []Name        `json:"names"`
+}
+
+// MoveSummary represents a move with its name and effect localized.
+// Power and accuracy are null for moves that do not use them, such as status moves.
+type MoveSummary struct {
+	ID            int            `json:"id"`
+	Name          string         `json:"name"`
+	LocalizedName *LocalizedName `json:"localized_name"`
+	Type          string         `json:"type"`
+	DamageClass   string         `json:"damage_class"`
+	Power         *int           `json:"power"`
+	Accuracy      *int           `json:"accuracy"`
+	PP            *int           `json:"pp"`
+	Priority      int            `json:"priority"`
+	EffectChance  *int           `json:"effect_chance"`
+	Target        string         `json:"target"`
+	Generation    string         `json:"generation"`
+	Effect        EffectText     `json:"effect"`
+}
@@ -1,18 +1,32 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"path"
+	"strconv"
+	"strings"
+)
 
-// Pokemon represents a Pokemon entity
+// Pokemon represents a Pokemon entity.
+// AlternateForms lists the other varieties of its species, and LocalizedName
+// is only set when a language is requested.
 type Pokemon struct {
-	ID             int            `json:"id"`
-	Name           string         `json:"name"`
-	Height         int            `json:"height"`
-	Weight         int            `json:"weight"`
-	BaseExperience int            `json:"base_experience"`
-	Types          []PokemonType  `json:"types"`
-	Abilities      []Ability      `json:"abilities"`
-	Stats          []Stat         `json:"stats"`
-	Sprites        Sprites        `json:"sprites"`
+	ID             int             `json:"id"`
+	Name           string          `json:"name"`
+	LocalizedName  *LocalizedName  `json:"localized_name,omitempty"`
+	Height         int             `json:"height"`
+	Weight         int             `json:"weight"`
+	BaseExperience int             `json:"base_experience"`
+	Types          []PokemonType   `json:"types"`
+	Abilities      []Ability       `json:"abilities"`
+	Stats          []Stat          `json:"stats"`
+	Sprites        Sprites         `json:"sprites"`
+	Species        NamedResource   `json:"species"`
+	Moves          []PokemonMove   `json:"moves"`
+	HeldItems      []HeldItem      `json:"held_items"`
+	Forms          []NamedResource `json:"forms"`
+	Cries          Cries           `json:"cries"`
+	AlternateForms []AlternateForm `json:"alternate_forms,omitempty"`
 }
 
 // PokemonType represents a Pokemon type
@@ -23,21 +37,25 @@ type PokemonType struct {
 
 // Type represents a type (like fire, water, etc.)
 type Type struct {
-	Name string `json:"name"`
-	URL  string `json:"url"`
+	Name          string         `json:"name"`
+	URL           string         `json:"url"`
+	LocalizedName *LocalizedName `json:"localized_name,omitempty"`
 }
 
-// Ability represents a Pokemon ability
+// Ability represents a Pokemon ability.
+// Effect is only set when the abilities are expanded.
 type Ability struct {
 	IsHidden bool        `json:"is_hidden"`
 	Slot     int         `json:"slot"`
 	Ability  AbilityInfo `json:"ability"`
+	Effect   *EffectText `json:"effect,omitempty"`
 }
 
 // AbilityInfo represents ability information
 type AbilityInfo struct {
-	Name string `json:"name"`
-	URL  string `json:"url"`
+	Name          string         `json:"name"`
+	URL           string         `json:"url"`
+	LocalizedName *LocalizedName `json:"localized_name,omitempty"`
 }
 
 // Stat represents a Pokemon stat
@@ -53,12 +71,24 @@ type StatInfo struct {
 	URL  string `json:"url"`
 }
 
-// Sprites represents Pokemon sprite images
-type Sprites struct {
-	FrontDefault string `json:"front_default"`
-	FrontShiny   string `json:"front_shiny"`
-	BackDefault  string `json:"back_default"`
-	BackShiny    string `json:"back_shiny"`
+// Cries represents the URLs of a Pokemon's cry recordings.
+// Legacy is empty for Pokemon introduced after the original cries were replaced.
+type Cries struct {
+	Latest string `json:"latest"`
+	Legacy string `json:"legacy"`
+}
+
+// Cry variants
+const (
+	CryLatest = "latest"
+	CryLegacy = "legacy"
+)
+
+// Asset represents a binary file, such as a cry, served through the API
+type Asset struct {
+	Name        string
+	ContentType string
+	Data        []byte
 }
 
 // PokemonCount represents the count of Pokemon
@@ -66,6 +96,49 @@ type PokemonCount struct {
 	Count int `json:"count"`
 }
 
+// NamedResource represents a named reference to another PokeAPI resource
+type NamedResource struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// ID returns the numeric ID at the end of the resource URL, or 0 if it has none
+func (r NamedResource) ID() int {
+	id, err := strconv.Atoi(path.Base(strings.TrimSuffix(r.URL, "/")))
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+// APIResource represents an unnamed reference to another PokeAPI resource
+type APIResource struct {
+	URL string `json:"url"`
+}
+
+// ID returns the numeric ID at the end of the resource URL, or 0 if it has none
+func (r APIResource) ID() int {
+	return NamedResource{URL: r.URL}.ID()
+}
+
+// ResourceList represents a single page of a paginated resource listing
+type ResourceList struct {
+	Items    []NamedResource `json:"items"`
+	Total    int             `json:"total"`
+	Page     int             `json:"page"`
+	PageSize int             `json:"page_size"`
+}
+
+// HasNext reports whether there is a page after this one
+func (l *ResourceList) HasNext() bool {
+	return l.Page*l.PageSize < l.Total
+}
+
+// HasPrev reports whether there is a page before this one
+func (l *ResourceList) HasPrev() bool {
+	return l.Page > 1
+}
+
 // PokemonService defines the interface for Pokemon business logic
 type PokemonService interface {
 	// GetByName retrieves a Pokemon by name or ID
@@ -73,6 +146,90 @@ type PokemonService interface {
 
 	// GetCount retrieves the total count of Pokemon
 	GetCount(ctx context.Context) (*PokemonCount, error)
+
+	// List retrieves a page of Pokemon
+	List(ctx context.Context, page, limit int) (*ResourceList, error)
+
+	// GetSpecies retrieves the species of a Pokemon, localized by filter
+	GetSpecies(ctx context.Context, nameOrID string, filter SpeciesFilter) (*SpeciesSummary, error)
+
+	// GetEvolution retrieves the evolution chain a Pokemon belongs to
+	GetEvolution(ctx context.Context, nameOrID string) (*EvolutionChain, error)
+
+	// ListTypes retrieves a page of types
+	ListTypes(ctx context.Context, page, limit int) (*ResourceList, error)
+
+	// GetType retrieves a type and its damage relations, with its name in the most preferred of languages
+	GetType(ctx context.Context, name string, languages []string) (*TypeDetails, error)
+
+	// GetMatchups retrieves the defensive type matchups of a Pokemon
+	GetMatchups(ctx context.Context, nameOrID string) (*TypeMatchups, error)
+
+	// GetAbility retrieves an ability with its name and effect text in the most preferred of languages
+	GetAbility(ctx context.Context, name string, languages []string) (*AbilitySummary, error)
+
+	// ExpandAbilities inlines the effect text in the most preferred of languages into each of a Pokemon's abilities
+	ExpandAbilities(ctx context.Context, pokemon *Pokemon, languages []string) error
+
+	// LocalizePokemon names a Pokemon, its types, its abilities and its moves in the most preferred of languages
+	LocalizePokemon(ctx context.Context, pokemon *Pokemon, languages []string) error
+
+	// LocalizeMoves names the moves of a moveset in the most preferred of languages
+	LocalizeMoves(ctx context.Context, moves *PokemonMoves, languages []string) error
+
+	// GetMoves retrieves the moves a Pokemon can learn, narrowed by filter
+	GetMoves(ctx context.Context, nameOrID string, filter MoveFilter) (*PokemonMoves, error)
+
+	// GetMove retrieves a move with its name and effect text in the most preferred of languages
+	GetMove(ctx context.Context, name string, languages []string) (*MoveSummary, error)
+
+	// GetItem retrieves an item with its effect text in the most preferred of languages
+	GetItem(ctx context.Context, name string, languages []string) (*ItemSummary, error)
+
+	// GetBerry retrieves a berry and the item it is, with effect text in the most preferred of languages
+	GetBerry(ctx context.Context, name string, languages []string) (*BerrySummary, error)
+
+	// Compare retrieves several Pokemon side by side, with their stat deltas, shared and unique traits and type head-to-heads
+	Compare(ctx context.Context, namesOrIDs []string) (*PokemonComparison, error)
+
+	// GetStats retrieves a Pokemon's base stats with their percentiles, rank within its primary type and computed ranges
+	GetStats(ctx context.Context, nameOrID string) (*PokemonStats, error)
+
+	// GetForms retrieves every variety of a Pokemon's species and the forms of each
+	GetForms(ctx context.Context, nameOrID string) (*PokemonForms, error)
+
+	// ListAlternateForms lists the other varieties of a Pokemon's species, with their types and stats, on the Pokemon
+	ListAlternateForms(ctx context.Context, pokemon *Pokemon) error
+
+	// GetCry retrieves the audio of a Pokemon's latest or legacy cry
+	GetCry(ctx context.Context, nameOrID, variant string) (*Asset, error)
+
+	// GetSprite retrieves one of a Pokemon's sprites, resized to fit within size pixels and encoded as format
+	GetSprite(ctx context.Context, nameOrID, kind string, size int, format string) (*Asset, error)
+
+	// GetEncounters retrieves where a Pokemon can be caught, optionally in a single version
+	GetEncounters(ctx context.Context, nameOrID, version string) (*PokemonEncounters, error)
+
+	// GetLocation retrieves a location and the Pokemon found in each of its areas
+	GetLocation(ctx context.Context, name string) (*LocationSummary, error)
+
+	// ListGenerations retrieves a page of generations
+	ListGenerations(ctx context.Context, page, limit int) (*ResourceList, error)
+
+	// GetGeneration retrieves a generation and what it introduced
+	GetGeneration(ctx context.Context, name string) (*GenerationSummary, error)
+
+	// ListRegions retrieves a page of regions
+	ListRegions(ctx context.Context, page, limit int) (*ResourceList, error)
+
+	// GetRegion retrieves a region with its Pokedexes, locations and games
+	GetRegion(ctx context.Context, name string) (*RegionSummary, error)
+
+	// ListPokedexes retrieves a page of Pokedexes
+	ListPokedexes(ctx context.Context, page, limit int) (*ResourceList, error)
+
+	// GetPokedex retrieves a Pokedex with a page of its entries
+	GetPokedex(ctx context.Context, name string, page, limit int) (*Pokedex, error)
 }
 
 // PokemonClient defines the interface for external Pokemon API client
@@ -82,4 +239,55 @@ type PokemonClient interface {
 
 	// FetchPokemonCount fetches the total count from the external API
 	FetchPokemonCount(ctx context.Context) (int, error)
+
+	// FetchPokemonList fetches a slice of the Pokemon listing and the total count
+	FetchPokemonList(ctx context.Context, offset, limit int) ([]NamedResource, int, error)
+
+	// FetchSpecies fetches a Pokemon species by name or ID from the external API
+	FetchSpecies(ctx context.Context, nameOrID string) (*Species, error)
+
+	// FetchEvolutionChain fetches an evolution chain by ID from the external API
+	FetchEvolutionChain(ctx context.Context, id int) (*EvolutionChain, error)
+
+	// FetchResourceList fetches a slice of any named resource listing, such as "type", from the external API
+	FetchResourceList(ctx context.Context, endpoint string, offset, limit int) ([]NamedResource, int, error)
+
+	// FetchType fetches a type by name or ID from the external API
+	FetchType(ctx context.Context, name string) (*TypeDetails, error)
+
+	// FetchAbility fetches an ability by name or ID from the external API
+	FetchAbility(ctx context.Context, name string) (*AbilityDetails, error)
+
+	// FetchMove fetches a move by name or ID from the external API
+	FetchMove(ctx context.Context, name string) (*MoveDetails, error)
+
+	// FetchItem fetches an item by name or ID from the external API
+	FetchItem(ctx context.Context, name string) (*ItemDetails, error)
+
+	// FetchBerry fetches a berry by name or ID from the external API
+	FetchBerry(ctx context.Context, name string) (*BerryDetails, error)
+
+	// FetchForm fetches a Pokemon form by name or ID from the external API
+	FetchForm(ctx context.Context, name string) (*FormDetails, error)
+
+	// FetchAsset downloads a binary file, such as a cry, from a URL referenced by the external API
+	FetchAsset(ctx context.Context, url string) ([]byte, error)
+
+	// FetchEncounters fetches the location areas a Pokemon can be encountered in from the external API
+	FetchEncounters(ctx context.Context, nameOrID string) ([]LocationAreaEncounter, error)
+
+	// FetchLocation fetches a location by name or ID from the external API
+	FetchLocation(ctx context.Context, name string) (*LocationDetails, error)
+
+	// FetchLocationArea fetches a location area by name or ID from the external API
+	FetchLocationArea(ctx context.Context, name string) (*LocationAreaDetails, error)
+
+	// FetchGeneration fetches a generation by name or ID from the external API
+	FetchGeneration(ctx context.Context, name string) (*GenerationDetails, error)
+
+	// FetchRegion fetches a region by name or ID from the external API
+	FetchRegion(ctx context.Context, name string) (*RegionDetails, error)
+
+	// FetchPokedex fetches a Pokedex by name or ID from the external API
+	FetchPokedex(ctx context.Context, name string) (*PokedexDetails, error)
 }
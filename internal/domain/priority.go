@@ -0,0 +1,18 @@
+package domain
+
+import "context"
+
+type backgroundKey struct{}
+
+// AsBackground returns a context whose upstream requests are background work,
+// such as index builds, which must not compete with user requests for the
+// shared rate limit or trip the shared circuit breaker
+func AsBackground(ctx context.Context) context.Context {
+	return context.WithValue(ctx, backgroundKey{}, true)
+}
+
+// IsBackground reports whether upstream requests made with ctx are background work
+func IsBackground(ctx context.Context) bool {
+	background, _ := ctx.Value(backgroundKey{}).(bool)
+	return background
+}
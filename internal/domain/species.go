@@ -0,0 +1,76 @@
+package domain
+
+// Species represents a Pokemon species as returned by the PokeAPI
+type Species struct {
+	ID                 int               `json:"id"`
+	Name               string            `json:"name"`
+	GenderRate         int               `json:"gender_rate"`
+	CaptureRate        int               `json:"capture_rate"`
+	BaseHappiness      int               `json:"base_happiness"`
+	IsBaby             bool              `json:"is_baby"`
+	IsLegendary        bool              `json:"is_legendary"`
+	IsMythical         bool              `json:"is_mythical"`
+	Generation         NamedResource     `json:"generation"`
+	GrowthRate         NamedResource     `json:"growth_rate"`
+	Color              NamedResource     `json:"color"`
+	Habitat            *NamedResource    `json:"habitat"`
+	EvolvesFromSpecies *NamedResource    `json:"evolves_from_species"`
+	EvolutionChain     APIResource       `json:"evolution_chain"`
+	Genera             []Genus           `json:"genera"`
+	FlavorTextEntries  []FlavorTextEntry `json:"flavor_text_entries"`
+	Varieties          []SpeciesVariety  `json:"varieties"`
+	Names              []Name            `json:"names"`
+}
+
+// Genus represents a localized genus, e.g. "Mouse Pokémon"
+type Genus struct {
+	Genus    string        `json:"genus"`
+	Language NamedResource `json:"language"`
+}
+
+// FlavorTextEntry represents a localized Pokédex entry for one game version
+type FlavorTextEntry struct {
+	FlavorText string        `json:"flavor_text"`
+	Language   NamedResource `json:"language"`
+	Version    NamedResource `json:"version"`
+}
+
+// SpeciesSummary represents a cleaned-up projection of a species for one language
+type SpeciesSummary struct {
+	ID            int            `json:"id"`
+	Name          string         `json:"name"`
+	LocalizedName *LocalizedName `json:"localized_name"`
+	Language      string         `json:"language"`
+	Genus         string         `json:"genus"`
+	FlavorText    []FlavorText   `json:"flavor_text"`
+	CaptureRate   int            `json:"capture_rate"`
+	BaseHappiness int            `json:"base_happiness"`
+	GenderRatio   *GenderRatio   `json:"gender_ratio"`
+	IsBaby        bool           `json:"is_baby"`
+	IsLegendary   bool           `json:"is_legendary"`
+	IsMythical    bool           `json:"is_mythical"`
+	Generation    string         `json:"generation"`
+	GrowthRate    string         `json:"growth_rate"`
+	Color         string         `json:"color"`
+	Habitat       string         `json:"habitat,omitempty"`
+	EvolvesFrom   string         `json:"evolves_from,omitempty"`
+}
+
+// FlavorText represents a de-duplicated Pokédex entry and the versions that use it
+type FlavorText struct {
+	Text     string   `json:"text"`
+	Versions []string `json:"versions"`
+}
+
+// GenderRatio represents the male/female split in percent; nil means genderless
+type GenderRatio struct {
+	Male   float64 `json:"male"`
+	Female float64 `json:"female"`
+}
+
+// SpeciesFilter selects which localized species data is returned.
+// Languages are in order of preference.
+type SpeciesFilter struct {
+	Languages []string
+	Version   string
+}
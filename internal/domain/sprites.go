@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Sprite set names accepted by Sprites.Select besides "<generation>/<game>[/animated]"
+const (
+	SpriteSetDefault         = "default"
+	SpriteSetOfficialArtwork = "official-artwork"
+	SpriteSetHome            = "home"
+	SpriteSetDreamWorld      = "dream-world"
+	SpriteSetShowdown        = "showdown"
+)
+
+// Sprites represents Pokemon sprite images.
+// Images a Pokemon does not have, such as female variants, are empty.
+type Sprites struct {
+	FrontDefault     string       `json:"front_default"`
+	FrontShiny       string       `json:"front_shiny"`
+	BackDefault      string       `json:"back_default"`
+	BackShiny        string       `json:"back_shiny"`
+	FrontFemale      string       `json:"front_female"`
+	FrontShinyFemale string       `json:"front_shiny_female"`
+	BackFemale       string       `json:"back_female"`
+	BackShinyFemale  string       `json:"back_shiny_female"`
+	Other            OtherSprites `json:"other"`
+
+	// Versions maps a generation, e.g. "generation-v", to the sprites of each of its games, e.g. "black-white"
+	Versions map[string]map[string]SpriteSet `json:"versions"`
+}
+
+// OtherSprites represents the artwork and sprites that do not come from the main series games
+type OtherSprites struct {
+	DreamWorld      SpriteSet `json:"dream_world"`
+	Home            SpriteSet `json:"home"`
+	OfficialArtwork SpriteSet `json:"official-artwork"`
+	Showdown        SpriteSet `json:"showdown"`
+}
+
+// SpriteSet represents one set of sprite images.
+// Sets differ in which images they have, so missing images are omitted.
+type SpriteSet struct {
+	FrontDefault          string     `json:"front_default,omitempty"`
+	FrontShiny            string     `json:"front_shiny,omitempty"`
+	FrontFemale           string     `json:"front_female,omitempty"`
+	FrontShinyFemale      string     `json:"front_shiny_female,omitempty"`
+	BackDefault           string     `json:"back_default,omitempty"`
+	BackShiny             string     `json:"back_shiny,omitempty"`
+	BackFemale            string     `json:"back_female,omitempty"`
+	BackShinyFemale       string     `json:"back_shiny_female,omitempty"`
+	FrontGray             string     `json:"front_gray,omitempty"`
+	BackGray              string     `json:"back_gray,omitempty"`
+	FrontTransparent      string     `json:"front_transparent,omitempty"`
+	BackTransparent       string     `json:"back_transparent,omitempty"`
+	FrontShinyTransparent string     `json:"front_shiny_transparent,omitempty"`
+	BackShinyTransparent  string     `json:"back_shiny_transparent,omitempty"`
+	Animated              *SpriteSet `json:"animated,omitempty"`
+}
+
+// Select returns a single set of sprites by name: "default", "official-artwork", "home",
+// "dream-world", "showdown", or a game such as "generation-v/black-white", optionally
+// followed by "/animated".
+func (s *Sprites) Select(name string) (*SpriteSet, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case SpriteSetDefault:
+		return &SpriteSet{
+			FrontDefault:     s.FrontDefault,
+			FrontShiny:       s.FrontShiny,
+			FrontFemale:      s.FrontFemale,
+			FrontShinyFemale: s.FrontShinyFemale,
+			BackDefault:      s.BackDefault,
+			BackShiny:        s.BackShiny,
+			BackFemale:       s.BackFemale,
+			BackShinyFemale:  s.BackShinyFemale,
+		}, nil
+	case SpriteSetOfficialArtwork:
+		return &s.Other.OfficialArtwork, nil
+	case SpriteSetHome:
+		return &s.Other.Home, nil
+	case SpriteSetDreamWorld:
+		return &s.Other.DreamWorld, nil
+	case SpriteSetShowdown:
+		return &s.Other.Showdown, nil
+	}
+
+	parts := strings.Split(strings.ToLower(strings.TrimSpace(name)), "/")
+	if len(parts) == 2 || (len(parts) == 3 && parts[2] == "animated") {
+		if set, ok := s.Versions[parts[0]][parts[1]]; ok {
+			if len(parts) == 2 {
+				return &set, nil
+			}
+			if set.Animated != nil {
+				return set.Animated, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("%w: invalid sprite_set %q: must be one of %s, %s, %s, %s, %s or <generation>/<game>[/animated]",
+		ErrInvalidInput, name, SpriteSetDefault, SpriteSetOfficialArtwork, SpriteSetHome, SpriteSetDreamWorld, SpriteSetShowdown)
+}
+
+// SpriteKinds lists the sprite kinds accepted by Sprites.URL
+var SpriteKinds = []string{
+	"front-default", "front-shiny", "front-female", "front-shiny-female",
+	"back-default", "back-shiny", "back-female", "back-shiny-female",
+	"official-artwork", "official-artwork-shiny", "home", "home-shiny",
+}
+
+// URL returns the image URL of a single sprite kind, such as "front-default" or "official-artwork".
+// Underscores may be used instead of hyphens. The URL is empty when the Pokemon has no such sprite.
+func (s *Sprites) URL(kind string) (string, error) {
+	switch strings.ReplaceAll(strings.ToLower(strings.TrimSpace(kind)), "_", "-") {
+	case "front-default":
+		return s.FrontDefault, nil
+	case "front-shiny":
+		return s.FrontShiny, nil
+	case "front-female":
+		return s.FrontFemale, nil
+	case "front-shiny-female":
+		return s.FrontShinyFemale, nil
+	case "back-default":
+		return s.BackDefault, nil
+	case "back-shiny":
+		return s.BackShiny, nil
+	case "back-female":
+		return s.BackFemale, nil
+	case "back-shiny-female":
+		return s.BackShinyFemale, nil
+	case "official-artwork":
+		return s.Other.OfficialArtwork.FrontDefault, nil
+	case "official-artwork-shiny":
+		return s.Other.OfficialArtwork.FrontShiny, nil
+	case "home":
+		return s.Other.Home.FrontDefault, nil
+	case "home-shiny":
+		return s.Other.Home.FrontShiny, nil
+	default:
+		return "", fmt.Errorf("%w: invalid sprite kind %q: must be one of %s", ErrInvalidInput, kind, strings.Join(SpriteKinds, ", "))
+	}
+}
@@ -0,0 +1,51 @@
+package domain
+
+// PokemonStats represents a Pokemon's base stats compared against every other Pokemon.
+// Population is the number of Pokemon the percentiles are computed over.
+type PokemonStats struct {
+	ID          int            `json:"id"`
+	Name        string         `json:"name"`
+	PrimaryType string         `json:"primary_type"`
+	Population  int            `json:"population"`
+	Total       StatTotal      `json:"total"`
+	Stats       []StatAnalysis `json:"stats"`
+}
+
+// StatTotal represents a Pokemon's base stat total
+type StatTotal struct {
+	Base       int      `json:"base"`
+	Percentile float64  `json:"percentile"`
+	TypeRank   StatRank `json:"type_rank"`
+}
+
+// StatAnalysis represents one base stat with its standing and the values it reaches in battle
+type StatAnalysis struct {
+	Name       string     `json:"name"`
+	Base       int        `json:"base"`
+	Effort     int        `json:"effort"`
+	Percentile float64    `json:"percentile"`
+	TypeRank   StatRank   `json:"type_rank"`
+	Level50    StatRanges `json:"level_50"`
+	Level100   StatRanges `json:"level_100"`
+}
+
+// StatRank represents a position among the Pokemon of a type, where 1 is the highest.
+// Tied Pokemon share a rank.
+type StatRank struct {
+	Rank int `json:"rank"`
+	Of   int `json:"of"`
+}
+
+// StatRanges represents the values a stat can reach at one level under each kind of nature.
+// Natures do not affect HP, so Hindering and Beneficial are omitted for it.
+type StatRanges struct {
+	Hindering  *StatRange `json:"hindering,omitempty"`
+	Neutral    StatRange  `json:"neutral"`
+	Beneficial *StatRange `json:"beneficial,omitempty"`
+}
+
+// StatRange represents the lowest and highest value of a stat, from no IVs or EVs to perfect IVs and full EVs
+type StatRange struct {
+	Min int `json:"min"`
+	Max int `json:"max"`
+}
@@ -0,0 +1,66 @@
+package domain
+
+// TypeDetails represents a type with its damage relations
+type TypeDetails struct {
+	ID              int             `json:"id"`
+	Name            string          `json:"name"`
+	DamageRelations DamageRelations `json:"damage_relations"`
+	Generation      NamedResource   `json:"generation"`
+	MoveDamageClass *NamedResource  `json:"move_damage_class"`
+	Pokemon         []TypePokemon   `json:"pokemon"`
+	Names           []Name          `json:"names"`
+	LocalizedName   *LocalizedName  `json:"localized_name,omitempty"`
+}
+
+// DamageRelations lists the types a type is strong or weak against, attacking and defending
+type DamageRelations struct {
+	NoDamageTo       []NamedResource `json:"no_damage_to"`
+	HalfDamageTo     []NamedResource `json:"half_damage_to"`
+	DoubleDamageTo   []NamedResource `json:"double_damage_to"`
+	NoDamageFrom     []NamedResource `json:"no_damage_from"`
+	HalfDamageFrom   []NamedResource `json:"half_damage_from"`
+	DoubleDamageFrom []NamedResource `json:"double_damage_from"`
+}
+
+// TypePokemon represents a Pokemon that has a type, and in which slot
+type TypePokemon struct {
+	Slot    int           `json:"slot"`
+	Pokemon NamedResource `json:"pokemon"`
+}
+
+// DamageFrom returns the multiplier applied to damage this type takes from an attacking type
+func (t *TypeDetails) DamageFrom(attacking string) float64 {
+	switch {
+	case containsResource(t.DamageRelations.NoDamageFrom, attacking):
+		return 0
+	case containsResource(t.DamageRelations.HalfDamageFrom, attacking):
+		return 0.5
+	case containsResource(t.DamageRelations.DoubleDamageFrom, attacking):
+		return 2
+	default:
+		return 1
+	}
+}
+
+// TypeMatchups represents how much damage a Pokemon takes from each attacking type
+type TypeMatchups struct {
+	Pokemon     string             `json:"pokemon"`
+	Types       []string           `json:"types"`
+	Multipliers map[string]float64 `json:"multipliers"`
+	Immune      []string           `json:"immune"`
+	Quarter     []string           `json:"quarter"`
+	Half        []string           `json:"half"`
+	Neutral     []string           `json:"neutral"`
+	Double      []string           `json:"double"`
+	Quadruple   []string           `json:"quadruple"`
+}
+
+// containsResource reports whether resources includes one with the given name
+func containsResource(resources []NamedResource, name string) bool {
+	for _, resource := range resources {
+		if resource.Name == name {
+			return true
+		}
+	}
+	return false
+}
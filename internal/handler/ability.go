@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// GetAbility godoc
+// @Summary Get ability
+// @Description Get an ability with its short and long effect text, the generation it was introduced in and the Pokemon that can have it
+// @Tags abilities
+// @Accept json
+// @Produce json
+// @Param name path string true "Ability name (e.g., 'static') or ID"
+// @Param lang query string false "Comma-separated languages in order of preference, overriding Accept-Language and falling back to English"
+// @Param Accept-Language header string false "Preferred languages, e.g. 'es-MX, es;q=0.9'"
+// @Success 200 {object} domain.AbilitySummary
+// @Success 304 "Not modified"
+// @Failure 404 {object} ErrorResponse "Ability not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/abilities/{name} [get]
+func (h *Handler) GetAbility(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	h.logger.Info("GetAbility request",
+		zap.String("name", name),
+		zap.String("query", r.URL.RawQuery),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	ability, err := h.pokemonService.GetAbility(ctx, name, requestLanguages(r))
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+	writeContentLanguage(w, localizedLanguage(ability.LocalizedName), ability.Effect.Language)
+
+	WriteCachedJSON(w, r, http.StatusOK, ability, h.cacheControl.ReferenceMaxAge, h.logger)
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// ComparePokemon godoc
+// @Summary Compare Pokemon
+// @Description Compare two to six Pokemon side by side: base stats with deltas against the first, shared and unique types and abilities, height and weight ratios, and how hard each pair hits each other with moves of their own types
+// @Tags pokemon
+// @Accept json
+// @Produce json
+// @Param ids query string true "Comma-separated Pokemon names or IDs (e.g., 'pikachu,raichu,25')"
+// @Param If-None-Match header string false "ETag of a previously fetched response"
+// @Success 200 {object} domain.PokemonComparison
+// @Success 304 "Not modified"
+// @Failure 400 {object} ErrorResponse "Invalid input"
+// @Failure 404 {object} ErrorResponse "Pokemon not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/pokemon/compare [get]
+func (h *Handler) ComparePokemon(w http.ResponseWriter, r *http.Request) {
+	h.logger.Info("ComparePokemon request",
+		zap.String("query", r.URL.RawQuery),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	var ids []string
+	if raw := r.URL.Query().Get("ids"); raw != "" {
+		ids = strings.Split(raw, ",")
+	}
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	comparison, err := h.pokemonService.Compare(ctx, ids)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+
+	WriteCachedJSON(w, r, http.StatusOK, comparison, h.cacheControl.PokemonMaxAge, h.logger)
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// GetPokemonCry godoc
+// @Summary Get Pokemon cry
+// @Description Stream a Pokemon's cry as OGG audio. Supports Range requests.
+// @Tags pokemon
+// @Produce audio/ogg
+// @Param nameOrId path string true "Pokemon name (e.g., 'pikachu') or ID (e.g., '25')"
+// @Param variant query string false "Cry recording: latest or legacy" default(latest)
+// @Param Range header string false "Byte range to return, e.g. 'bytes=0-1023'"
+// @Success 200 {file} binary
+// @Success 206 {file} binary "Partial content"
+// @Success 304 "Not modified"
+// @Failure 400 {object} ErrorResponse "Invalid input"
+// @Failure 404 {object} ErrorResponse "Pokemon or cry not found"
+// @Failure 416 "Range not satisfiable"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/pokemon/{nameOrId}/cry [get]
+func (h *Handler) GetPokemonCry(w http.ResponseWriter, r *http.Request) {
+	nameOrID := chi.URLParam(r, "nameOrId")
+
+	h.logger.Info("GetPokemonCry request",
+		zap.String("name_or_id", nameOrID),
+		zap.String("query", r.URL.RawQuery),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	cry, err := h.pokemonService.GetCry(ctx, nameOrID, r.URL.Query().Get("variant"))
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+
+	WriteCachedAsset(w, r, cry, h.cacheControl.AssetMaxAge)
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// GetPokemonEncounters godoc
+// @Summary Get Pokemon encounters
+// @Description Get where a Pokemon can be caught, grouped by game version and location area, with level range, method, conditions and chance
+// @Tags pokemon
+// @Accept json
+// @Produce json
+// @Param nameOrId path string true "Pokemon name (e.g., 'pikachu') or ID (e.g., '25')"
+// @Param version query string false "Only include encounters in this game version (e.g., 'red')"
+// @Success 200 {object} domain.PokemonEncounters
+// @Success 304 "Not modified"
+// @Failure 400 {object} ErrorResponse "Invalid input"
+// @Failure 404 {object} ErrorResponse "Pokemon not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/pokemon/{nameOrId}/encounters [get]
+func (h *Handler) GetPokemonEncounters(w http.ResponseWriter, r *http.Request) {
+	nameOrID := chi.URLParam(r, "nameOrId")
+
+	h.logger.Info("GetPokemonEncounters request",
+		zap.String("name_or_id", nameOrID),
+		zap.String("query", r.URL.RawQuery),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	encounters, err := h.pokemonService.GetEncounters(ctx, nameOrID, r.URL.Query().Get("version"))
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+
+	WriteCachedJSON(w, r, http.StatusOK, encounters, h.cacheControl.PokemonMaxAge, h.logger)
+}
+
+// GetLocation godoc
+// @Summary Get location
+// @Description Get a location and the Pokemon found in each of its areas
+// @Tags locations
+// @Accept json
+// @Produce json
+// @Param name path string true "Location name (e.g., 'viridian-forest') or ID"
+// @Success 200 {object} domain.LocationSummary
+// @Success 304 "Not modified"
+// @Failure 404 {object} ErrorResponse "Location not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/locations/{name} [get]
+func (h *Handler) GetLocation(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	h.logger.Info("GetLocation request",
+		zap.String("name", name),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	location, err := h.pokemonService.GetLocation(ctx, name)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+
+	WriteCachedJSON(w, r, http.StatusOK, location, h.cacheControl.ReferenceMaxAge, h.logger)
+}
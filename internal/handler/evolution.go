@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// GetPokemonEvolution godoc
+// @Summary Get Pokemon evolution chain
+// @Description Get the evolution family of a Pokemon as a tree of stages, each with the conditions required to reach it
+// @Tags pokemon
+// @Accept json
+// @Produce json
+// @Param nameOrId path string true "Pokemon name (e.g., 'pikachu') or ID (e.g., '25')"
+// @Success 200 {object} domain.EvolutionChain
+// @Success 304 "Not modified"
+// @Failure 400 {object} ErrorResponse "Invalid input"
+// @Failure 404 {object} ErrorResponse "Pokemon not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/pokemon/{nameOrId}/evolution [get]
+func (h *Handler) GetPokemonEvolution(w http.ResponseWriter, r *http.Request) {
+	nameOrID := chi.URLParam(r, "nameOrId")
+
+	h.logger.Info("GetPokemonEvolution request",
+		zap.String("name_or_id", nameOrID),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	chain, err := h.pokemonService.GetEvolution(ctx, nameOrID)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+
+	WriteCachedJSON(w, r, http.StatusOK, chain, h.cacheControl.ReferenceMaxAge, h.logger)
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// GetPokemonForms godoc
+// @Summary Get Pokemon forms
+// @Description Get every variety of a Pokemon's species (regional forms, megas, gigantamax) with its types and stats, and the forms of each
+// @Tags pokemon
+// @Accept json
+// @Produce json
+// @Param nameOrId path string true "Pokemon name (e.g., 'pikachu') or ID (e.g., '25')"
+// @Success 200 {object} domain.PokemonForms
+// @Success 304 "Not modified"
+// @Failure 400 {object} ErrorResponse "Invalid input"
+// @Failure 404 {object} ErrorResponse "Pokemon not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/pokemon/{nameOrId}/forms [get]
+func (h *Handler) GetPokemonForms(w http.ResponseWriter, r *http.Request) {
+	nameOrID := chi.URLParam(r, "nameOrId")
+
+	h.logger.Info("GetPokemonForms request",
+		zap.String("name_or_id", nameOrID),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	forms, err := h.pokemonService.GetForms(ctx, nameOrID)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+
+	WriteCachedJSON(w, r, http.StatusOK, forms, h.cacheControl.ReferenceMaxAge, h.logger)
+}
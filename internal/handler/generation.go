@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// PokedexResponse represents a Pokedex with a page of its entries and links to the neighbouring pages
+type PokedexResponse struct {
+	*domain.Pokedex
+	Next *string `json:"next"`
+	Prev *string `json:"prev"`
+}
+
+// ListGenerations godoc
+// @Summary List generations
+// @Description Get a paginated list of generations
+// @Tags generations
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number, starting at 1" default(1)
+// @Param limit query int false "Number of generations per page (max: 100)" default(20)
+// @Success 200 {object} ListResponse
+// @Failure 400 {object} ErrorResponse "Invalid query parameters"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/generations [get]
+func (h *Handler) ListGenerations(w http.ResponseWriter, r *http.Request) {
+	h.logger.Info("ListGenerations request",
+		zap.String("query", r.URL.RawQuery),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	page, limit, err := parsePagination(r)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	list, err := h.pokemonService.ListGenerations(ctx, page, limit)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+
+	WriteCachedJSON(w, r, http.StatusOK, newListResponse(r, list), h.cacheControl.ReferenceMaxAge, h.logger)
+}
+
+// GetGeneration godoc
+// @Summary Get generation
+// @Description Get a generation with its main region, version groups, and the species, types, moves and abilities it introduced
+// @Tags generations
+// @Accept json
+// @Produce json
+// @Param name path string true "Generation name (e.g., 'generation-i') or ID"
+// @Success 200 {object} domain.GenerationSummary
+// @Success 304 "Not modified"
+// @Failure 404 {object} ErrorResponse "Generation not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/generations/{name} [get]
+func (h *Handler) GetGeneration(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	h.logger.Info("GetGeneration request",
+		zap.String("name", name),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	generation, err := h.pokemonService.GetGeneration(ctx, name)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+
+	WriteCachedJSON(w, r, http.StatusOK, generation, h.cacheControl.ReferenceMaxAge, h.logger)
+}
+
+// ListRegions godoc
+// @Summary List regions
+// @Description Get a paginated list of regions
+// @Tags regions
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number, starting at 1" default(1)
+// @Param limit query int false "Number of regions per page (max: 100)" default(20)
+// @Success 200 {object} ListResponse
+// @Failure 400 {object} ErrorResponse "Invalid query parameters"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/regions [get]
+func (h *Handler) ListRegions(w http.ResponseWriter, r *http.Request) {
+	h.logger.Info("ListRegions request",
+		zap.String("query", r.URL.RawQuery),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	page, limit, err := parsePagination(r)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	list, err := h.pokemonService.ListRegions(ctx, page, limit)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+
+	WriteCachedJSON(w, r, http.StatusOK, newListResponse(r, list), h.cacheControl.ReferenceMaxAge, h.logger)
+}
+
+// GetRegion godoc
+// @Summary Get region
+// @Description Get a region with its main generation, Pokedexes, version groups and locations
+// @Tags regions
+// @Accept json
+// @Produce json
+// @Param name path string true "Region name (e.g., 'kanto') or ID"
+// @Success 200 {object} domain.RegionSummary
+// @Success 304 "Not modified"
+// @Failure 404 {object} ErrorResponse "Region not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/regions/{name} [get]
+func (h *Handler) GetRegion(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	h.logger.Info("GetRegion request",
+		zap.String("name", name),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	region, err := h.pokemonService.GetRegion(ctx, name)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+
+	WriteCachedJSON(w, r, http.StatusOK, region, h.cacheControl.ReferenceMaxAge, h.logger)
+}
+
+// ListPokedexes godoc
+// @Summary List Pokedexes
+// @Description Get a paginated list of Pokedexes, national and regional
+// @Tags pokedexes
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number, starting at 1" default(1)
+// @Param limit query int false "Number of Pokedexes per page (max: 100)" default(20)
+// @Success 200 {object} ListResponse
+// @Failure 400 {object} ErrorResponse "Invalid query parameters"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/pokedexes [get]
+func (h *Handler) ListPokedexes(w http.ResponseWriter, r *http.Request) {
+	h.logger.Info("ListPokedexes request",
+		zap.String("query", r.URL.RawQuery),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	page, limit, err := parsePagination(r)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	list, err := h.pokemonService.ListPokedexes(ctx, page, limit)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+
+	WriteCachedJSON(w, r, http.StatusOK, newListResponse(r, list), h.cacheControl.ReferenceMaxAge, h.logger)
+}
+
+// GetPokedex godoc
+// @Summary Get Pokedex
+// @Description Get a Pokedex with a page of its entries, ordered by entry number
+// @Tags pokedexes
+// @Accept json
+// @Produce json
+// @Param name path string true "Pokedex name (e.g., 'kanto', 'national') or ID"
+// @Param page query int false "Page number, starting at 1" default(1)
+// @Param limit query int false "Number of entries per page (max: 100)" default(20)
+// @Success 200 {object} PokedexResponse
+// @Success 304 "Not modified"
+// @Failure 400 {object} ErrorResponse "Invalid query parameters"
+// @Failure 404 {object} ErrorResponse "Pokedex not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/pokedexes/{name} [get]
+func (h *Handler) GetPokedex(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	h.logger.Info("GetPokedex request",
+		zap.String("name", name),
+		zap.String("query", r.URL.RawQuery),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	page, limit, err := parsePagination(r)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	pokedex, err := h.pokemonService.GetPokedex(ctx, name, page, limit)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	resp := PokedexResponse{Pokedex: pokedex}
+	if pokedex.HasNext() {
+		link := pageLink(r, pokedex.Page+1, pokedex.PageSize)
+		resp.Next = &link
+	}
+	if pokedex.HasPrev() {
+		link := pageLink(r, pokedex.Page-1, pokedex.PageSize)
+		resp.Prev = &link
+	}
+
+	writeCacheStatus(w, cacheInfo)
+
+	WriteCachedJSON(w, r, http.StatusOK, resp, h.cacheControl.ReferenceMaxAge, h.logger)
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"github.com/polgarcia/golang-rest-api/internal/config"
 	"github.com/polgarcia/golang-rest-api/internal/domain"
 	"github.com/polgarcia/golang-rest-api/pkg/logger"
 )
@@ -8,13 +9,39 @@ import (
 // Handler is the base handler with dependencies
 type Handler struct {
 	pokemonService domain.PokemonService
+	upstream       domain.UpstreamMonitor
+	cacheControl   config.HTTPCacheConfig
 	logger         *logger.Logger
 }
 
+// Option configures optional Handler dependencies
+type Option func(*Handler)
+
+// WithUpstreamMonitor reports the external API connection state in health checks
+func WithUpstreamMonitor(monitor domain.UpstreamMonitor) Option {
+	return func(h *Handler) {
+		h.upstream = monitor
+	}
+}
+
+// WithCacheControl sets the Cache-Control max-age advertised by each route.
+// Without it responses carry "no-cache" and clients must revalidate with the ETag.
+func WithCacheControl(cfg config.HTTPCacheConfig) Option {
+	return func(h *Handler) {
+		h.cacheControl = cfg
+	}
+}
+
 // NewHandler creates a new handler with dependencies
-func NewHandler(pokemonService domain.PokemonService, log *logger.Logger) *Handler {
-	return &Handler{
+func NewHandler(pokemonService domain.PokemonService, log *logger.Logger, opts ...Option) *Handler {
+	h := &Handler{
 		pokemonService: pokemonService,
 		logger:         log,
 	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
 }
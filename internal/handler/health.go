@@ -3,17 +3,25 @@ package handler
 import (
 	"net/http"
 	"time"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
 )
 
 // HealthResponse represents a health check response
 type HealthResponse struct {
-	Status    string    `json:"status"`
-	Timestamp time.Time `json:"timestamp"`
+	Status    string          `json:"status"`
+	Timestamp time.Time       `json:"timestamp"`
+	Upstream  *UpstreamHealth `json:"upstream,omitempty"`
+}
+
+// UpstreamHealth represents the state of the external API connection
+type UpstreamHealth struct {
+	CircuitBreaker domain.CircuitState `json:"circuit_breaker"`
 }
 
 // HealthCheck godoc
 // @Summary Health check
-// @Description Check if the API is healthy and running
+// @Description Check if the API is healthy and running. Status is "degraded" while the PokeAPI circuit breaker is open.
 // @Tags health
 // @Accept json
 // @Produce json
@@ -25,5 +33,14 @@ func (h *Handler) HealthCheck(w http.ResponseWriter, r *http.Request) {
 		Timestamp: time.Now().UTC(),
 	}
 
+	if h.upstream != nil {
+		state := h.upstream.CircuitState()
+		response.Upstream = &UpstreamHealth{CircuitBreaker: state}
+
+		if state == domain.CircuitOpen {
+			response.Status = "degraded"
+		}
+	}
+
 	WriteJSON(w, http.StatusOK, response, h.logger)
 }
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// GetItem godoc
+// @Summary Get item
+// @Description Get an item with its cost, category, effect text, sprite, fling power and the wild Pokemon that may hold it
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param name path string true "Item name (e.g., 'light-ball') or ID"
+// @Param lang query string false "Comma-separated languages in order of preference, overriding Accept-Language and falling back to English"
+// @Param Accept-Language header string false "Preferred languages, e.g. 'es-MX, es;q=0.9'"
+// @Success 200 {object} domain.ItemSummary
+// @Success 304 "Not modified"
+// @Failure 404 {object} ErrorResponse "Item not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/items/{name} [get]
+func (h *Handler) GetItem(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	h.logger.Info("GetItem request",
+		zap.String("name", name),
+		zap.String("query", r.URL.RawQuery),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	item, err := h.pokemonService.GetItem(ctx, name, requestLanguages(r))
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+	writeContentLanguage(w, item.Effect.Language)
+
+	WriteCachedJSON(w, r, http.StatusOK, item, h.cacheControl.ReferenceMaxAge, h.logger)
+}
+
+// GetBerry godoc
+// @Summary Get berry
+// @Description Get a berry with its firmness, growth, flavors and natural gift, plus the item it is
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param name path string true "Berry name (e.g., 'cheri') or ID"
+// @Param lang query string false "Comma-separated languages in order of preference, overriding Accept-Language and falling back to English"
+// @Param Accept-Language header string false "Preferred languages, e.g. 'es-MX, es;q=0.9'"
+// @Success 200 {object} domain.BerrySummary
+// @Success 304 "Not modified"
+// @Failure 404 {object} ErrorResponse "Berry not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/berries/{name} [get]
+func (h *Handler) GetBerry(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	h.logger.Info("GetBerry request",
+		zap.String("name", name),
+		zap.String("query", r.URL.RawQuery),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	berry, err := h.pokemonService.GetBerry(ctx, name, requestLanguages(r))
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+	writeContentLanguage(w, berry.Item.Effect.Language)
+
+	WriteCachedJSON(w, r, http.StatusOK, berry, h.cacheControl.ReferenceMaxAge, h.logger)
+}
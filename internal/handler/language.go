@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+)
+
+// requestLanguages returns the languages a client asked for, most preferred first.
+// The comma-separated lang query parameter overrides the Accept-Language header.
+// A nil result means the client has no preference.
+func requestLanguages(r *http.Request) []string {
+	if lang := r.URL.Query().Get("lang"); lang != "" {
+		var languages []string
+		for _, language := range strings.Split(lang, ",") {
+			if language = strings.TrimSpace(language); language != "" {
+				languages = append(languages, language)
+			}
+		}
+		return languages
+	}
+
+	return parseAcceptLanguage(r.Header.Get("Accept-Language"))
+}
+
+// localizeNames reports whether resource names should be localized for a request:
+// when the lang query parameter is given, or when the most preferred language is
+// not the one names are already given in. Browsers send Accept-Language with every
+// request, so English-speaking clients are not charged for lookups they don't need.
+func localizeNames(r *http.Request, languages []string) bool {
+	if r.URL.Query().Get("lang") != "" {
+		return true
+	}
+	if len(languages) == 0 {
+		return false
+	}
+
+	primary, _, _ := strings.Cut(languages[0], "-")
+	return !strings.EqualFold(primary, domain.DefaultLanguage)
+}
+
+// parseAcceptLanguage returns the language tags in an Accept-Language header ordered by quality.
+// Tags with a quality of zero, the "*" wildcard and malformed qualities are dropped.
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		tag     string
+		quality float64
+	}
+
+	var tags []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		quality := 1.0
+		if q, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		tags = append(tags, weighted{tag: tag, quality: quality})
+	}
+
+	// Stable, so tags of equal quality keep the order the client listed them in
+	slices.SortStableFunc(tags, func(a, b weighted) int {
+		switch {
+		case a.quality > b.quality:
+			return -1
+		case a.quality < b.quality:
+			return 1
+		}
+		return 0
+	})
+
+	languages := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		languages = append(languages, tag.tag)
+	}
+	if len(languages) == 0 {
+		return nil
+	}
+	return languages
+}
+
+// writeContentLanguage sets Content-Language to the languages a response was localized in.
+// Vary is set regardless, as the languages depend on the Accept-Language header.
+func writeContentLanguage(w http.ResponseWriter, languages ...string) {
+	w.Header().Add("Vary", "Accept-Language")
+
+	var distinct []string
+	for _, language := range languages {
+		if language != "" && !slices.Contains(distinct, language) {
+			distinct = append(distinct, language)
+		}
+	}
+	if len(distinct) > 0 {
+		w.Header().Set("Content-Language", strings.Join(distinct, ", "))
+	}
+}
+
+// localizedLanguage returns the language of name, or "" if it was not localized
+func localizedLanguage(name *domain.LocalizedName) string {
+	if name == nil {
+		return ""
+	}
+	return name.Language
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// GetPokemonMoves godoc
+// @Summary Get Pokemon moves
+// @Description Get the moves a Pokemon can learn, with learn method, level and version group
+// @Tags pokemon
+// @Accept json
+// @Produce json
+// @Param nameOrId path string true "Pokemon name (e.g., 'pikachu') or ID (e.g., '25')"
+// @Param version_group query string false "Only include moves learned in this version group (e.g., 'red-blue')"
+// @Param method query string false "Only include moves learned this way (e.g., 'level-up', 'machine', 'egg', 'tutor')"
+// @Param lang query string false "Comma-separated languages of localized move names in order of preference, overriding Accept-Language"
+// @Param Accept-Language header string false "Preferred languages, e.g. 'es-MX, es;q=0.9'; names are only localized when lang is given or the preferred language is not English"
+// @Success 200 {object} domain.PokemonMoves
+// @Success 304 "Not modified"
+// @Failure 400 {object} ErrorResponse "Invalid input"
+// @Failure 404 {object} ErrorResponse "Pokemon not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/pokemon/{nameOrId}/moves [get]
+func (h *Handler) GetPokemonMoves(w http.ResponseWriter, r *http.Request) {
+	nameOrID := chi.URLParam(r, "nameOrId")
+
+	h.logger.Info("GetPokemonMoves request",
+		zap.String("name_or_id", nameOrID),
+		zap.String("query", r.URL.RawQuery),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	filter := domain.MoveFilter{
+		VersionGroup: r.URL.Query().Get("version_group"),
+		Method:       r.URL.Query().Get("method"),
+	}
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	moves, err := h.pokemonService.GetMoves(ctx, nameOrID, filter)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	languages := requestLanguages(r)
+	if localizeNames(r, languages) {
+		if err := h.pokemonService.LocalizeMoves(ctx, moves, languages); err != nil {
+			// Localized names are a convenience; the moves are still served without them
+			h.logger.Warn("Serving moves without localized names",
+				zap.String("pokemon", moves.Pokemon),
+				zap.Strings("languages", languages),
+				zap.Error(err),
+			)
+		}
+	}
+
+	var localizedLanguages []string
+	for _, move := range moves.Moves {
+		localizedLanguages = append(localizedLanguages, localizedLanguage(move.LocalizedName))
+	}
+
+	writeCacheStatus(w, cacheInfo)
+	writeContentLanguage(w, localizedLanguages...)
+
+	WriteCachedJSON(w, r, http.StatusOK, moves, h.cacheControl.PokemonMaxAge, h.logger)
+}
+
+// GetMove godoc
+// @Summary Get move
+// @Description Get a move with its power, accuracy, PP, damage class, type and effect text
+// @Tags moves
+// @Accept json
+// @Produce json
+// @Param name path string true "Move name (e.g., 'thunderbolt') or ID"
+// @Param lang query string false "Comma-separated languages in order of preference, overriding Accept-Language and falling back to English"
+// @Param Accept-Language header string false "Preferred languages, e.g. 'es-MX, es;q=0.9'"
+// @Success 200 {object} domain.MoveSummary
+// @Success 304 "Not modified"
+// @Failure 404 {object} ErrorResponse "Move not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/moves/{name} [get]
+func (h *Handler) GetMove(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	h.logger.Info("GetMove request",
+		zap.String("name", name),
+		zap.String("query", r.URL.RawQuery),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	move, err := h.pokemonService.GetMove(ctx, name, requestLanguages(r))
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+	writeContentLanguage(w, localizedLanguage(move.LocalizedName), move.Effect.Language)
+
+	WriteCachedJSON(w, r, http.StatusOK, move, h.cacheControl.ReferenceMaxAge, h.logger)
+}
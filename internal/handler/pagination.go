@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+)
+
+const (
+	defaultPage     = 1
+	defaultPageSize = 20
+)
+
+// ListResponse represents a paginated list response
+type ListResponse struct {
+	Items    []domain.NamedResource `json:"items"`
+	Total    int                    `json:"total"`
+	Page     int                    `json:"page"`
+	PageSize int                    `json:"page_size"`
+	Next     *string                `json:"next"`
+	Prev     *string                `json:"prev"`
+}
+
+// parsePagination reads the page and limit query parameters, applying defaults
+func parsePagination(r *http.Request) (page, limit int, err error) {
+	page, err = queryInt(r, "page", defaultPage)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	limit, err = queryInt(r, "limit", defaultPageSize)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return page, limit, nil
+}
+
+// queryInt parses an integer query parameter, returning def when it is absent
+func queryInt(r *http.Request, name string, def int) (int, error) {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		return def, nil
+	}
+
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid %s: must be an integer", domain.ErrInvalidInput, name)
+	}
+
+	return value, nil
+}
+
+// newListResponse builds a list response with next/prev links relative to the request path
+func newListResponse(r *http.Request, list *domain.ResourceList) ListResponse {
+	resp := ListResponse{
+		Items:    list.Items,
+		Total:    list.Total,
+		Page:     list.Page,
+		PageSize: list.PageSize,
+	}
+
+	if list.HasNext() {
+		link := pageLink(r, list.Page+1, list.PageSize)
+		resp.Next = &link
+	}
+	if list.HasPrev() {
+		link := pageLink(r, list.Page-1, list.PageSize)
+		resp.Prev = &link
+	}
+
+	return resp
+}
+
+// pageLink builds a link to the given page, preserving other query parameters
+func pageLink(r *http.Request, page, limit int) string {
+	query := r.URL.Query()
+	query.Set("page", strconv.Itoa(page))
+	query.Set("limit", strconv.Itoa(limit))
+
+	link := url.URL{Path: r.URL.Path, RawQuery: query.Encode()}
+	return link.String()
+}
@@ -2,13 +2,56 @@ package handler
 
 import (
 	"errors"
+	"fmt"
+	"math"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/polgarcia/golang-rest-api/internal/domain"
 	"go.uber.org/zap"
 )
 
+// ListPokemon godoc
+// @Summary List Pokemon
+// @Description Get a paginated list of Pokemon
+// @Tags pokemon
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number, starting at 1" default(1)
+// @Param limit query int false "Number of Pokemon per page (max: 100)" default(20)
+// @Success 200 {object} ListResponse
+// @Failure 400 {object} ErrorResponse "Invalid query parameters"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/pokemon [get]
+func (h *Handler) ListPokemon(w http.ResponseWriter, r *http.Request) {
+	h.logger.Info("ListPokemon request",
+		zap.String("query", r.URL.RawQuery),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	page, limit, err := parsePagination(r)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	list, err := h.pokemonService.List(ctx, page, limit)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+
+	WriteCachedJSON(w, r, http.StatusOK, newListResponse(r, list), h.cacheControl.ListMaxAge, h.logger)
+}
+
 // GetPokemonByName godoc
 // @Summary Get Pokemon by name or ID
 // @Description Get detailed information about a Pokemon by name or ID
@@ -16,7 +59,13 @@ import (
 // @Accept json
 // @Produce json
 // @Param nameOrId path string true "Pokemon name (e.g., 'pikachu') or ID (e.g., '25')"
+// @Param expand query string false "Comma-separated related data to inline: abilities"
+// @Param lang query string false "Comma-separated languages of localized names and expanded text in order of preference, overriding Accept-Language"
+// @Param Accept-Language header string false "Preferred languages, e.g. 'es-MX, es;q=0.9'; names are only localized when lang is given or the preferred language is not English"
+// @Param sprite_set query string false "Return a single set of sprites: default, official-artwork, home, dream-world, showdown or <generation>/<game>[/animated]"
+// @Param If-None-Match header string false "ETag of a previously fetched response"
 // @Success 200 {object} domain.Pokemon
+// @Success 304 "Not modified"
 // @Failure 400 {object} ErrorResponse "Invalid input"
 // @Failure 404 {object} ErrorResponse "Pokemon not found"
 // @Failure 500 {object} ErrorResponse "Internal server error"
@@ -30,15 +79,69 @@ func (h *Handler) GetPokemonByName(w http.ResponseWriter, r *http.Request) {
 		zap.String("path", r.URL.Path),
 	)
 
+	expand, err := parseExpand(r, expandAbilities)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
 	// Get Pokemon from service
-	pokemon, err := h.pokemonService.GetByName(r.Context(), nameOrID)
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	pokemon, err := h.pokemonService.GetByName(ctx, nameOrID)
 	if err != nil {
 		h.handlePokemonError(w, err)
 		return
 	}
 
+	languages := requestLanguages(r)
+	if localizeNames(r, languages) {
+		if err := h.pokemonService.LocalizePokemon(ctx, pokemon, languages); err != nil {
+			// Localized names are a convenience; the Pokemon is still served without them
+			h.logger.Warn("Serving Pokemon without localized names",
+				zap.String("pokemon", pokemon.Name),
+				zap.Strings("languages", languages),
+				zap.Error(err),
+			)
+		}
+	}
+
+	if expand[expandAbilities] {
+		if err := h.pokemonService.ExpandAbilities(ctx, pokemon, languages); err != nil {
+			h.handlePokemonError(w, err)
+			return
+		}
+	}
+
+	if err := h.pokemonService.ListAlternateForms(ctx, pokemon); err != nil {
+		// The listing is a convenience; the Pokemon is still served without it
+		h.logger.Warn("Serving Pokemon without alternate forms",
+			zap.String("pokemon", pokemon.Name),
+			zap.Error(err),
+		)
+	}
+
+	var resp interface{} = pokemon
+	if set := r.URL.Query().Get("sprite_set"); set != "" {
+		sprites, err := pokemon.Sprites.Select(set)
+		if err != nil {
+			h.handlePokemonError(w, err)
+			return
+		}
+		resp = spriteSetResponse{Pokemon: pokemon, Sprites: sprites}
+	}
+
+	writeCacheStatus(w, cacheInfo)
+	writeContentLanguage(w, localizedLanguage(pokemon.LocalizedName))
+
 	// Return success response
-	WriteJSON(w, http.StatusOK, pokemon, h.logger)
+	WriteCachedJSON(w, r, http.StatusOK, resp, h.cacheControl.PokemonMaxAge, h.logger)
+}
+
+// spriteSetResponse is a Pokemon whose sprites are narrowed to a single set.
+// Its Sprites field hides the embedded Pokemon's when encoded.
+type spriteSetResponse struct {
+	*domain.Pokemon
+	Sprites *domain.SpriteSet `json:"sprites"`
 }
 
 // GetPokemonCount godoc
@@ -53,13 +156,16 @@ func (h *Handler) GetPokemonByName(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) GetPokemonCount(w http.ResponseWriter, r *http.Request) {
 	// Missing request logging (Claude should catch this)
 
-	count, err := h.pokemonService.GetCount(r.Context())
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	count, err := h.pokemonService.GetCount(ctx)
 	if err != nil {
 		h.handlePokemonError(w, err)
 		return
 	}
 
-	WriteJSON(w, http.StatusOK, count, h.logger)
+	writeCacheStatus(w, cacheInfo)
+
+	WriteCachedJSON(w, r, http.StatusOK, count, h.cacheControl.CountMaxAge, h.logger)
 }
 
 // handlePokemonError handles Pokemon-related errors and writes appropriate HTTP responses
@@ -67,8 +173,26 @@ func (h *Handler) handlePokemonError(w http.ResponseWriter, err error) {
 	switch {
 	case errors.Is(err, domain.ErrPokemonNotFound):
 		WriteError(w, http.StatusNotFound, "Pokemon not found", h.logger)
+	case errors.Is(err, domain.ErrResourceNotFound):
+		WriteError(w, http.StatusNotFound, notFoundMessage(err), h.logger)
 	case errors.Is(err, domain.ErrInvalidInput):
 		WriteError(w, http.StatusBadRequest, err.Error(), h.logger)
+	case errors.Is(err, domain.ErrRateLimited):
+		var rateErr *domain.RateLimitError
+		if errors.As(err, &rateErr) {
+			writeRetryAfter(w, rateErr.RetryAfter)
+		}
+		h.logger.Warn("Outbound rate limit exceeded", zap.Error(err))
+		WriteError(w, http.StatusServiceUnavailable, "Too many requests to external API, please retry later", h.logger)
+	case errors.Is(err, domain.ErrNotReady):
+		message := "Resource is not ready yet, please retry later"
+		var notReady *domain.NotReadyError
+		if errors.As(err, &notReady) {
+			writeRetryAfter(w, notReady.RetryAfter)
+			message = strings.ToUpper(notReady.Resource[:1]) + notReady.Resource[1:] + " is not ready yet, please retry later"
+		}
+		h.logger.Warn("Resource not ready", zap.Error(err))
+		WriteError(w, http.StatusServiceUnavailable, message, h.logger)
 	case errors.Is(err, domain.ErrExternalAPI):
 		h.logger.Error("External API error", zap.Error(err))
 		WriteError(w, http.StatusBadGateway, "Failed to fetch data from external API", h.logger)
@@ -77,3 +201,43 @@ func (h *Handler) handlePokemonError(w http.ResponseWriter, err error) {
 		WriteError(w, http.StatusInternalServerError, "Internal server error", h.logger)
 	}
 }
+
+// writeRetryAfter sets Retry-After to delay, rounded up to whole seconds
+func writeRetryAfter(w http.ResponseWriter, delay time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+}
+
+// notFoundMessage returns a user-facing message such as "Type not found"
+func notFoundMessage(err error) string {
+	var notFound *domain.NotFoundError
+	if !errors.As(err, &notFound) || notFound.Resource == "" {
+		return "Resource not found"
+	}
+	return strings.ToUpper(notFound.Resource[:1]) + notFound.Resource[1:] + " not found"
+}
+
+// expandAbilities inlines ability effect text into a Pokemon response
+const expandAbilities = "abilities"
+
+// parseExpand reads the comma-separated expand query parameter, rejecting values not in allowed
+func parseExpand(r *http.Request, allowed ...string) (map[string]bool, error) {
+	expand := make(map[string]bool)
+
+	raw := r.URL.Query().Get("expand")
+	if raw == "" {
+		return expand, nil
+	}
+
+	for _, value := range strings.Split(raw, ",") {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value == "" {
+			continue
+		}
+		if !slices.Contains(allowed, value) {
+			return nil, fmt.Errorf("%w: invalid expand %q: must be one of %s", domain.ErrInvalidInput, value, strings.Join(allowed, ", "))
+		}
+		expand[value] = true
+	}
+
+	return expand, nil
+}
@@ -1,9 +1,16 @@
 package handler
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
+	"github.com/polgarcia/golang-rest-api/internal/domain"
 	"github.com/polgarcia/golang-rest-api/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -26,6 +33,45 @@ func WriteJSON(w http.ResponseWriter, status int, data interface{}, log *logger.
 	}
 }
 
+// WriteCachedJSON writes a cacheable JSON response. It sets a strong ETag computed
+// over the encoded body and a Cache-Control header with the given max-age, and
+// answers 304 Not Modified when If-None-Match already names the current ETag.
+func WriteCachedJSON(w http.ResponseWriter, r *http.Request, status int, data interface{}, maxAge time.Duration, log *logger.Logger) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(data); err != nil {
+		log.Error("Failed to encode JSON response", zap.Error(err))
+		WriteError(w, http.StatusInternalServerError, "Internal server error", log)
+		return
+	}
+
+	etag := computeETag(body.Bytes())
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", cacheControl(maxAge))
+
+	if status == http.StatusOK && etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if _, err := w.Write(body.Bytes()); err != nil {
+		log.Error("Failed to write JSON response", zap.Error(err))
+	}
+}
+
+// WriteCachedAsset serves a binary asset with a strong ETag over its contents and a
+// Cache-Control header with the given max-age. http.ServeContent answers conditional
+// and Range requests.
+func WriteCachedAsset(w http.ResponseWriter, r *http.Request, asset *domain.Asset, maxAge time.Duration) {
+	w.Header().Set("Content-Type", asset.ContentType)
+	w.Header().Set("ETag", computeETag(asset.Data))
+	w.Header().Set("Cache-Control", cacheControl(maxAge))
+
+	http.ServeContent(w, r, asset.Name, time.Time{}, bytes.NewReader(asset.Data))
+}
+
 // WriteError writes an error response
 func WriteError(w http.ResponseWriter, status int, message string, log *logger.Logger) {
 	errResp := ErrorResponse{
@@ -36,3 +82,52 @@ func WriteError(w http.ResponseWriter, status int, message string, log *logger.L
 
 	WriteJSON(w, status, errResp, log)
 }
+
+// computeETag returns a strong ETag for body
+func computeETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatches reports whether an If-None-Match header matches etag.
+// If-None-Match uses weak comparison, so a W/ prefix is ignored.
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cacheControl builds the Cache-Control header for a max-age; zero means clients must revalidate
+func cacheControl(maxAge time.Duration) string {
+	if maxAge <= 0 {
+		return "no-cache"
+	}
+
+	return fmt.Sprintf("public, max-age=%d", int(maxAge.Seconds()))
+}
+
+// writeCacheStatus sets the X-Cache header and, when stale data was served, a Warning header
+func writeCacheStatus(w http.ResponseWriter, info *domain.CacheInfo) {
+	status := info.Status()
+	if status == "" {
+		return
+	}
+
+	w.Header().Set("X-Cache", string(status))
+
+	switch {
+	case info.RevalidationFailed():
+		w.Header().Set("Warning", `111 - "Revalidation Failed"`)
+	case status == domain.CacheStale:
+		w.Header().Set("Warning", `110 - "Response is Stale"`)
+	}
+}
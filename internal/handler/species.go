@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// GetPokemonSpecies godoc
+// @Summary Get Pokemon species
+// @Description Get species data for a Pokemon: genus, de-duplicated Pokédex entries, capture rate, gender ratio and legendary/mythical flags
+// @Tags pokemon
+// @Accept json
+// @Produce json
+// @Param nameOrId path string true "Pokemon name (e.g., 'pikachu') or ID (e.g., '25')"
+// @Param lang query string false "Comma-separated languages in order of preference, overriding Accept-Language and falling back to English"
+// @Param Accept-Language header string false "Preferred languages, e.g. 'es-MX, es;q=0.9'"
+// @Param version query string false "Only include Pokédex entries from this game version (e.g., 'red')"
+// @Success 200 {object} domain.SpeciesSummary
+// @Success 304 "Not modified"
+// @Failure 400 {object} ErrorResponse "Invalid input"
+// @Failure 404 {object} ErrorResponse "Pokemon not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/pokemon/{nameOrId}/species [get]
+func (h *Handler) GetPokemonSpecies(w http.ResponseWriter, r *http.Request) {
+	nameOrID := chi.URLParam(r, "nameOrId")
+
+	h.logger.Info("GetPokemonSpecies request",
+		zap.String("name_or_id", nameOrID),
+		zap.String("query", r.URL.RawQuery),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	filter := domain.SpeciesFilter{
+		Languages: requestLanguages(r),
+		Version:   r.URL.Query().Get("version"),
+	}
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	species, err := h.pokemonService.GetSpecies(ctx, nameOrID, filter)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+	writeContentLanguage(w, localizedLanguage(species.LocalizedName), species.Language)
+
+	WriteCachedJSON(w, r, http.StatusOK, species, h.cacheControl.ReferenceMaxAge, h.logger)
+}
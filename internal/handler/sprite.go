@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// GetPokemonSprite godoc
+// @Summary Get Pokemon sprite
+// @Description Serve one of a Pokemon's sprites through the API, optionally resized and converted
+// @Tags pokemon
+// @Produce image/png
+// @Produce image/jpeg
+// @Param nameOrId path string true "Pokemon name (e.g., 'pikachu') or ID (e.g., '25')"
+// @Param kind path string true "Sprite kind: front-default, front-shiny, front-female, front-shiny-female, back-default, back-shiny, back-female, back-shiny-female, official-artwork, official-artwork-shiny, home or home-shiny"
+// @Param size query int false "Scale the sprite to fit within size x size pixels, rounded up to a power of two; 0 keeps the original size (max: 1024)" default(0)
+// @Param format query string false "Image format: png or jpeg" default(png)
+// @Success 200 {file} binary
+// @Success 304 "Not modified"
+// @Failure 400 {object} ErrorResponse "Invalid input"
+// @Failure 404 {object} ErrorResponse "Pokemon or sprite not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/pokemon/{nameOrId}/sprite/{kind} [get]
+func (h *Handler) GetPokemonSprite(w http.ResponseWriter, r *http.Request) {
+	nameOrID := chi.URLParam(r, "nameOrId")
+	kind := chi.URLParam(r, "kind")
+
+	h.logger.Info("GetPokemonSprite request",
+		zap.String("name_or_id", nameOrID),
+		zap.String("kind", kind),
+		zap.String("query", r.URL.RawQuery),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	size, err := queryInt(r, "size", 0)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	sprite, err := h.pokemonService.GetSprite(ctx, nameOrID, kind, size, r.URL.Query().Get("format"))
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+
+	WriteCachedAsset(w, r, sprite, h.cacheControl.AssetMaxAge)
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// GetPokemonStats godoc
+// @Summary Get Pokemon stat analytics
+// @Description Get a Pokemon's base stats and total with their percentile among every Pokemon, rank within its primary type, and min/max values at levels 50 and 100 under hindering, neutral and beneficial natures
+// @Tags pokemon
+// @Accept json
+// @Produce json
+// @Param nameOrId path string true "Pokemon name (e.g., 'pikachu') or ID (e.g., '25')"
+// @Success 200 {object} domain.PokemonStats
+// @Success 304 "Not modified"
+// @Failure 400 {object} ErrorResponse "Invalid input"
+// @Failure 404 {object} ErrorResponse "Pokemon not found"
+// @Failure 503 {object} ErrorResponse "Stats of every Pokemon are still being fetched, retry after the Retry-After header"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/pokemon/{nameOrId}/stats [get]
+func (h *Handler) GetPokemonStats(w http.ResponseWriter, r *http.Request) {
+	nameOrID := chi.URLParam(r, "nameOrId")
+
+	h.logger.Info("GetPokemonStats request",
+		zap.String("name_or_id", nameOrID),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	stats, err := h.pokemonService.GetStats(ctx, nameOrID)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+
+	WriteCachedJSON(w, r, http.StatusOK, stats, h.cacheControl.PokemonMaxAge, h.logger)
+}
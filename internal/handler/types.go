@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// ListTypes godoc
+// @Summary List types
+// @Description Get a paginated list of Pokemon types
+// @Tags types
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number, starting at 1" default(1)
+// @Param limit query int false "Number of types per page (max: 100)" default(20)
+// @Success 200 {object} ListResponse
+// @Failure 400 {object} ErrorResponse "Invalid query parameters"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/types [get]
+func (h *Handler) ListTypes(w http.ResponseWriter, r *http.Request) {
+	h.logger.Info("ListTypes request",
+		zap.String("query", r.URL.RawQuery),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	page, limit, err := parsePagination(r)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	list, err := h.pokemonService.ListTypes(ctx, page, limit)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+
+	WriteCachedJSON(w, r, http.StatusOK, newListResponse(r, list), h.cacheControl.ReferenceMaxAge, h.logger)
+}
+
+// GetType godoc
+// @Summary Get type
+// @Description Get a Pokemon type with its damage relations and the Pokemon that have it
+// @Tags types
+// @Accept json
+// @Produce json
+// @Param name path string true "Type name (e.g., 'fire') or ID"
+// @Param lang query string false "Comma-separated languages in order of preference, overriding Accept-Language and falling back to English"
+// @Param Accept-Language header string false "Preferred languages, e.g. 'es-MX, es;q=0.9'"
+// @Success 200 {object} domain.TypeDetails
+// @Success 304 "Not modified"
+// @Failure 404 {object} ErrorResponse "Type not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/types/{name} [get]
+func (h *Handler) GetType(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	h.logger.Info("GetType request",
+		zap.String("name", name),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	details, err := h.pokemonService.GetType(ctx, name, requestLanguages(r))
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+	writeContentLanguage(w, localizedLanguage(details.LocalizedName))
+
+	WriteCachedJSON(w, r, http.StatusOK, details, h.cacheControl.ReferenceMaxAge, h.logger)
+}
+
+// GetPokemonMatchups godoc
+// @Summary Get Pokemon type matchups
+// @Description Get the damage multiplier (0, 0.25, 0.5, 1, 2 or 4) a Pokemon takes from each attacking type
+// @Tags pokemon
+// @Accept json
+// @Produce json
+// @Param nameOrId path string true "Pokemon name (e.g., 'pikachu') or ID (e.g., '25')"
+// @Success 200 {object} domain.TypeMatchups
+// @Success 304 "Not modified"
+// @Failure 400 {object} ErrorResponse "Invalid input"
+// @Failure 404 {object} ErrorResponse "Pokemon not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/pokemon/{nameOrId}/matchups [get]
+func (h *Handler) GetPokemonMatchups(w http.ResponseWriter, r *http.Request) {
+	nameOrID := chi.URLParam(r, "nameOrId")
+
+	h.logger.Info("GetPokemonMatchups request",
+		zap.String("name_or_id", nameOrID),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+
+	ctx, cacheInfo := domain.WithCacheInfo(r.Context())
+	matchups, err := h.pokemonService.GetMatchups(ctx, nameOrID)
+	if err != nil {
+		h.handlePokemonError(w, err)
+		return
+	}
+
+	writeCacheStatus(w, cacheInfo)
+
+	WriteCachedJSON(w, r, http.StatusOK, matchups, h.cacheControl.PokemonMaxAge, h.logger)
+}
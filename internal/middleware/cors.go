@@ -31,7 +31,8 @@ func CORS(allowedOrigins string) func(http.Handler) http.Handler {
 				}
 
 				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-				w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, Authorization, X-Request-ID")
+				w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, Authorization, If-None-Match, Range, X-Request-ID")
+				w.Header().Set("Access-Control-Expose-Headers", "Accept-Ranges, Content-Range, ETag, Retry-After, Warning, X-Cache, X-Request-ID")
 				w.Header().Set("Access-Control-Allow-Credentials", "true")
 				w.Header().Set("Access-Control-Max-Age", "3600")
 			}
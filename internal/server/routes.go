@@ -30,8 +30,52 @@ func SetupRoutes(h *handler.Handler, log *logger.Logger, corsOrigins string) *ch
 	r.Route("/api/v1", func(r chi.Router) {
 		// Pokemon endpoints
 		r.Route("/pokemon", func(r chi.Router) {
+			r.Get("/", h.ListPokemon)
 			r.Get("/count", h.GetPokemonCount)
+			r.Get("/compare", h.ComparePokemon)
 			r.Get("/{nameOrId}", h.GetPokemonByName)
+			r.Get("/{nameOrId}/species", h.GetPokemonSpecies)
+			r.Get("/{nameOrId}/evolution", h.GetPokemonEvolution)
+			r.Get("/{nameOrId}/stats", h.GetPokemonStats)
+			r.Get("/{nameOrId}/forms", h.GetPokemonForms)
+			r.Get("/{nameOrId}/cry", h.GetPokemonCry)
+			r.Get("/{nameOrId}/sprite/{kind}", h.GetPokemonSprite)
+			r.Get("/{nameOrId}/matchups", h.GetPokemonMatchups)
+			r.Get("/{nameOrId}/moves", h.GetPokemonMoves)
+			r.Get("/{nameOrId}/encounters", h.GetPokemonEncounters)
+		})
+
+		// Ability endpoints
+		r.Get("/abilities/{name}", h.GetAbility)
+
+		// Move endpoints
+		r.Get("/moves/{name}", h.GetMove)
+
+		// Item endpoints
+		r.Get("/items/{name}", h.GetItem)
+		r.Get("/berries/{name}", h.GetBerry)
+
+		// Location endpoints
+		r.Get("/locations/{name}", h.GetLocation)
+
+		// Type endpoints
+		r.Route("/types", func(r chi.Router) {
+			r.Get("/", h.ListTypes)
+			r.Get("/{name}", h.GetType)
+		})
+
+		// Generation, region and Pokedex endpoints
+		r.Route("/generations", func(r chi.Router) {
+			r.Get("/", h.ListGenerations)
+			r.Get("/{name}", h.GetGeneration)
+		})
+		r.Route("/regions", func(r chi.Router) {
+			r.Get("/", h.ListRegions)
+			r.Get("/{name}", h.GetRegion)
+		})
+		r.Route("/pokedexes", func(r chi.Router) {
+			r.Get("/", h.ListPokedexes)
+			r.Get("/{name}", h.GetPokedex)
 		})
 	})
 
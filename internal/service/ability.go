@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// GetAbility retrieves an ability with its name and effect text in the most preferred of languages.
+// Effect text is only translated into a few languages, so it falls back to DefaultLanguage.
+func (s *PokemonService) GetAbility(ctx context.Context, name string, languages []string) (*domain.AbilitySummary, error) {
+	// Validate input
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("%w: ability name cannot be empty", domain.ErrInvalidInput)
+	}
+
+	name = strings.ToLower(strings.TrimSpace(name))
+	languages = normalizeLanguages(languages)
+
+	s.logger.Info("Getting ability",
+		zap.String("name", name),
+		zap.Strings("languages", languages),
+	)
+
+	ability, err := s.client.FetchAbility(ctx, name)
+	if err != nil {
+		s.logger.Error("Failed to get ability",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	pokemon := ability.Pokemon
+	if pokemon == nil {
+		pokemon = []domain.AbilityPokemon{}
+	}
+
+	return &domain.AbilitySummary{
+		ID:            ability.ID,
+		Name:          ability.Name,
+		LocalizedName: domain.LocalizeName(ability.Names, languages),
+		Generation:    ability.Generation.Name,
+		Effect:        domain.LocalizeEffect(ability.EffectEntries, languages),
+		Pokemon:       pokemon,
+	}, nil
+}
+
+// ExpandAbilities inlines the effect text in the most preferred of languages into each of a Pokemon's abilities.
+// The abilities are fetched concurrently.
+func (s *PokemonService) ExpandAbilities(ctx context.Context, pokemon *domain.Pokemon, languages []string) error {
+	languages = normalizeLanguages(languages)
+
+	details, err := fetchAll(ctx, abilityNames(pokemon.Abilities), s.client.FetchAbility)
+	if err != nil {
+		s.logger.Error("Failed to expand abilities",
+			zap.String("pokemon", pokemon.Name),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	for i, ability := range details {
+		effect := domain.LocalizeEffect(ability.EffectEntries, languages)
+		pokemon.Abilities[i].Effect = &effect
+	}
+
+	return nil
+}
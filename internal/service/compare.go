@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+const (
+	// MinCompare and MaxCompare bound the number of Pokemon compared at once
+	MinCompare = 2
+	MaxCompare = 6
+)
+
+// Compare retrieves several Pokemon side by side: their stats and the deltas against
+// the first, the types and abilities they share or alone have, their relative size,
+// and how hard each pair hits each other with moves of their own types.
+// The Pokemon, and then their types, are fetched concurrently.
+func (s *PokemonService) Compare(ctx context.Context, namesOrIDs []string) (*domain.PokemonComparison, error) {
+	// Validate input
+	if len(namesOrIDs) < MinCompare || len(namesOrIDs) > MaxCompare {
+		return nil, fmt.Errorf("%w: must compare between %d and %d Pokemon", domain.ErrInvalidInput, MinCompare, MaxCompare)
+	}
+	for _, nameOrID := range namesOrIDs {
+		if strings.TrimSpace(nameOrID) == "" {
+			return nil, fmt.Errorf("%w: name or ID cannot be empty", domain.ErrInvalidInput)
+		}
+	}
+
+	s.logger.Info("Comparing Pokemon",
+		zap.Strings("pokemon", namesOrIDs),
+	)
+
+	members, err := fetchAll(ctx, namesOrIDs, s.GetByName)
+	if err != nil {
+		s.logger.Error("Failed to compare Pokemon",
+			zap.Strings("pokemon", namesOrIDs),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	var typeNamesUsed []string
+	for _, member := range members {
+		for _, name := range typeNames(member.Types) {
+			if !slices.Contains(typeNamesUsed, name) {
+				typeNamesUsed = append(typeNamesUsed, name)
+			}
+		}
+	}
+
+	details, err := fetchAll(ctx, typeNamesUsed, s.client.FetchType)
+	if err != nil {
+		s.logger.Error("Failed to compare Pokemon types",
+			zap.Strings("pokemon", namesOrIDs),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	types := make(map[string]*domain.TypeDetails, len(details))
+	for _, t := range details {
+		types[t.Name] = t
+	}
+
+	comparison := &domain.PokemonComparison{
+		Pokemon:    make([]string, 0, len(members)),
+		Stats:      compareStats(members),
+		Types:      compareTraits(members, func(p *domain.Pokemon) []string { return typeNames(p.Types) }),
+		Abilities:  compareTraits(members, func(p *domain.Pokemon) []string { return abilityNames(p.Abilities) }),
+		Size:       compareSize(members),
+		HeadToHead: []domain.TypeHeadToHead{},
+	}
+
+	for _, member := range members {
+		comparison.Pokemon = append(comparison.Pokemon, member.Name)
+	}
+
+	for i, first := range members {
+		for _, second := range members[i+1:] {
+			comparison.HeadToHead = append(comparison.HeadToHead, headToHead(first, second, types))
+		}
+	}
+
+	return comparison, nil
+}
+
+// compareStats aligns the base stats of members in the order PokeAPI lists them, followed by the total.
+// A stat a Pokemon lacks counts as 0.
+func compareStats(members []*domain.Pokemon) []domain.StatComparison {
+	var names []string
+	for _, member := range members {
+		for _, stat := range member.Stats {
+			if !slices.Contains(names, stat.Stat.Name) {
+				names = append(names, stat.Stat.Name)
+			}
+		}
+	}
+
+	values := make(map[string][]int, len(names)+1)
+	for _, member := range members {
+		stats := baseStats(member.Stats)
+		total := 0
+		for _, name := range names {
+			values[name] = append(values[name], stats[name])
+			total += stats[name]
+		}
+		values[totalStat] = append(values[totalStat], total)
+	}
+
+	comparisons := make([]domain.StatComparison, 0, len(names)+1)
+	for _, name := range names {
+		comparisons = append(comparisons, compareStat(name, values[name], members))
+	}
+
+	return append(comparisons, compareStat(totalStat, values[totalStat], members))
+}
+
+// compareStat computes the deltas against the first member and which members are highest
+func compareStat(name string, values []int, members []*domain.Pokemon) domain.StatComparison {
+	comparison := domain.StatComparison{
+		Name:    name,
+		Values:  values,
+		Deltas:  make([]int, len(values)),
+		Highest: []string{},
+	}
+
+	highest := slices.Max(values)
+	for i, value := range values {
+		comparison.Deltas[i] = value - values[0]
+		if value == highest && !slices.Contains(comparison.Highest, members[i].Name) {
+			comparison.Highest = append(comparison.Highest, members[i].Name)
+		}
+	}
+
+	return comparison
+}
+
+// compareTraits splits the traits of members into those every member has and those only one has
+func compareTraits(members []*domain.Pokemon, traitsOf func(*domain.Pokemon) []string) domain.TraitComparison {
+	traits := make([][]string, len(members))
+	holders := make(map[string]int)
+	for i, member := range members {
+		traits[i] = traitsOf(member)
+		for _, trait := range traits[i] {
+			holders[trait]++
+		}
+	}
+
+	comparison := domain.TraitComparison{
+		Shared: []string{},
+		Unique: make([][]string, len(members)),
+	}
+
+	for i := range members {
+		comparison.Unique[i] = []string{}
+		for _, trait := range traits[i] {
+			switch holders[trait] {
+			case len(members):
+				if i == 0 {
+					comparison.Shared = append(comparison.Shared, trait)
+				}
+			case 1:
+				comparison.Unique[i] = append(comparison.Unique[i], trait)
+			}
+		}
+	}
+
+	return comparison
+}
+
+// compareSize lists the heights and weights of members and their ratios to the first member's
+func compareSize(members []*domain.Pokemon) domain.SizeComparison {
+	size := domain.SizeComparison{
+		Heights:      make([]int, 0, len(members)),
+		Weights:      make([]int, 0, len(members)),
+		HeightRatios: make([]float64, 0, len(members)),
+		WeightRatios: make([]float64, 0, len(members)),
+	}
+
+	for _, member := range members {
+		size.Heights = append(size.Heights, member.Height)
+		size.Weights = append(size.Weights, member.Weight)
+		size.HeightRatios = append(size.HeightRatios, ratio(member.Height, members[0].Height))
+		size.WeightRatios = append(size.WeightRatios, ratio(member.Weight, members[0].Weight))
+	}
+
+	return size
+}
+
+// ratio returns value divided by base to two decimals, or 0 when base is 0
+func ratio(value, base int) float64 {
+	if base == 0 {
+		return 0
+	}
+	return math.Round(float64(value)/float64(base)*100) / 100
+}
+
+// headToHead compares how hard first and second hit each other with moves of their own types
+func headToHead(first, second *domain.Pokemon, types map[string]*domain.TypeDetails) domain.TypeHeadToHead {
+	result := domain.TypeHeadToHead{
+		First:           first.Name,
+		Second:          second.Name,
+		FirstAttacking:  bestAttack(first, second, types),
+		SecondAttacking: bestAttack(second, first, types),
+	}
+
+	switch {
+	case result.FirstAttacking.Multiplier > result.SecondAttacking.Multiplier:
+		result.Advantage = first.Name
+	case result.SecondAttacking.Multiplier > result.FirstAttacking.Multiplier:
+		result.Advantage = second.Name
+	}
+
+	return result
+}
+
+// bestAttack returns the attacker's type that deals the most damage to the defender.
+// Ties go to the attacker's earlier type.
+func bestAttack(attacker, defender *domain.Pokemon, types map[string]*domain.TypeDetails) domain.TypeAttack {
+	var best domain.TypeAttack
+
+	for i, attacking := range typeNames(attacker.Types) {
+		multiplier := 1.0
+		for _, defending := range defender.Types {
+			if details, ok := types[defending.Type.Name]; ok {
+				multiplier *= details.DamageFrom(attacking)
+			}
+		}
+
+		if i == 0 || multiplier > best.Multiplier {
+			best = domain.TypeAttack{Type: attacking, Multiplier: multiplier}
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"sync"
+)
+
+// fetchAll calls fetch for every name concurrently and returns the results in input order.
+// The remaining calls are cancelled as soon as one fails, and the first failure is returned.
+func fetchAll[T any](ctx context.Context, names []string, fetch func(ctx context.Context, name string) (T, error)) ([]T, error) {
+	return fetchAllLimit(ctx, names, len(names), fetch)
+}
+
+// fetchAllLimit is fetchAll with at most limit calls in flight at once
+func fetchAllLimit[T any](ctx context.Context, names []string, limit int, fetch func(ctx context.Context, name string) (T, error)) ([]T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+	results := make([]T, len(names))
+	slots := make(chan struct{}, max(limit, 1))
+
+	for i, name := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-ctx.Done():
+				fail(ctx.Err())
+				return
+			}
+
+			result, err := fetch(ctx, name)
+			if err != nil {
+				fail(err)
+				return
+			}
+			results[i] = result
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return results, nil
+}
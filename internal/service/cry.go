@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// cryContentType is the media type of PokeAPI's cry recordings
+const cryContentType = "audio/ogg"
+
+// GetCry retrieves the audio of a Pokemon's cry. Variant is "latest" (the default) or "legacy".
+func (s *PokemonService) GetCry(ctx context.Context, nameOrID, variant string) (*domain.Asset, error) {
+	variant = strings.ToLower(strings.TrimSpace(variant))
+	if variant == "" {
+		variant = domain.CryLatest
+	}
+	if variant != domain.CryLatest && variant != domain.CryLegacy {
+		return nil, fmt.Errorf("%w: invalid variant %q: must be %s or %s", domain.ErrInvalidInput, variant, domain.CryLatest, domain.CryLegacy)
+	}
+
+	pokemon, err := s.GetByName(ctx, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Getting cry",
+		zap.String("pokemon", pokemon.Name),
+		zap.String("variant", variant),
+	)
+
+	url := pokemon.Cries.Latest
+	if variant == domain.CryLegacy {
+		url = pokemon.Cries.Legacy
+	}
+	if url == "" {
+		return nil, domain.NotFound("cry")
+	}
+
+	data, err := s.client.FetchAsset(ctx, url)
+	if err != nil {
+		s.logger.Error("Failed to get cry",
+			zap.String("pokemon", pokemon.Name),
+			zap.String("variant", variant),
+			zap.Error(err),
+		)
+		if errors.Is(err, domain.ErrResourceNotFound) {
+			return nil, domain.NotFound("cry")
+		}
+		return nil, err
+	}
+
+	return &domain.Asset{
+		Name:        fmt.Sprintf("%s-%s.ogg", pokemon.Name, variant),
+		ContentType: cryContentType,
+		Data:        data,
+	}, nil
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// GetEncounters retrieves where a Pokemon can be caught, grouped by version and then location area.
+// When version is set only that version is returned.
+func (s *PokemonService) GetEncounters(ctx context.Context, nameOrID, version string) (*domain.PokemonEncounters, error) {
+	pokemon, err := s.GetByName(ctx, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	version = strings.ToLower(strings.TrimSpace(version))
+
+	s.logger.Info("Getting encounters",
+		zap.String("pokemon", pokemon.Name),
+		zap.String("version", version),
+	)
+
+	encounters, err := s.client.FetchEncounters(ctx, pokemon.Name)
+	if err != nil {
+		s.logger.Error("Failed to get encounters",
+			zap.String("pokemon", pokemon.Name),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return groupEncounters(pokemon.Name, encounters, version), nil
+}
+
+// groupEncounters regroups upstream encounters, which are keyed by location area, by version.
+// Versions and areas keep the order in which they first appear.
+func groupEncounters(pokemon string, encounters []domain.LocationAreaEncounter, version string) *domain.PokemonEncounters {
+	result := &domain.PokemonEncounters{
+		Pokemon:  pokemon,
+		Versions: []domain.VersionEncounters{},
+	}
+	index := make(map[string]int)
+
+	for _, area := range encounters {
+		for _, detail := range area.VersionDetails {
+			if version != "" && detail.Version.Name != version {
+				continue
+			}
+
+			i, ok := index[detail.Version.Name]
+			if !ok {
+				i = len(result.Versions)
+				index[detail.Version.Name] = i
+				result.Versions = append(result.Versions, domain.VersionEncounters{
+					Version: detail.Version.Name,
+					Areas:   []domain.AreaEncounters{},
+				})
+			}
+
+			result.Versions[i].Areas = append(result.Versions[i].Areas, domain.AreaEncounters{
+				LocationArea: area.LocationArea.Name,
+				MaxChance:    detail.MaxChance,
+				Encounters:   toEncounters(detail.EncounterDetails),
+			})
+		}
+	}
+
+	return result
+}
+
+// toEncounters flattens upstream encounter details
+func toEncounters(details []domain.EncounterDetail) []domain.Encounter {
+	encounters := make([]domain.Encounter, 0, len(details))
+	for _, detail := range details {
+		conditions := make([]string, 0, len(detail.ConditionValues))
+		for _, condition := range detail.ConditionValues {
+			conditions = append(conditions, condition.Name)
+		}
+
+		encounters = append(encounters, domain.Encounter{
+			Method:     detail.Method.Name,
+			MinLevel:   detail.MinLevel,
+			MaxLevel:   detail.MaxLevel,
+			Chance:     detail.Chance,
+			Conditions: conditions,
+		})
+	}
+	return encounters
+}
+
+// GetLocation retrieves a location and the Pokemon found in each of its areas.
+// The areas are fetched concurrently.
+func (s *PokemonService) GetLocation(ctx context.Context, name string) (*domain.LocationSummary, error) {
+	// Validate input
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("%w: location name cannot be empty", domain.ErrInvalidInput)
+	}
+
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	s.logger.Info("Getting location",
+		zap.String("name", name),
+	)
+
+	location, err := s.client.FetchLocation(ctx, name)
+	if err != nil {
+		s.logger.Error("Failed to get location",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	areas, err := fetchAll(ctx, resourceNames(location.Areas), s.client.FetchLocationArea)
+	if err != nil {
+		s.logger.Error("Failed to get location areas",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	summary := &domain.LocationSummary{
+		ID:      location.ID,
+		Name:    location.Name,
+		Areas:   make([]domain.LocationAreaPokemon, 0, len(areas)),
+		Pokemon: []string{},
+	}
+	if location.Region != nil {
+		summary.Region = location.Region.Name
+	}
+
+	seen := make(map[string]bool)
+	for _, area := range areas {
+		areaPokemon := domain.LocationAreaPokemon{
+			Name:    area.Name,
+			Pokemon: make([]domain.AreaPokemon, 0, len(area.PokemonEncounters)),
+		}
+
+		for _, encounter := range area.PokemonEncounters {
+			areaPokemon.Pokemon = append(areaPokemon.Pokemon, summarizeAreaPokemon(encounter))
+
+			if !seen[encounter.Pokemon.Name] {
+				seen[encounter.Pokemon.Name] = true
+				summary.Pokemon = append(summary.Pokemon, encounter.Pokemon.Name)
+			}
+		}
+
+		summary.Areas = append(summary.Areas, areaPokemon)
+	}
+
+	return summary, nil
+}
+
+// summarizeAreaPokemon collapses a Pokemon's encounters in an area into its versions and level range
+func summarizeAreaPokemon(encounter domain.AreaPokemonEncounter) domain.AreaPokemon {
+	result := domain.AreaPokemon{
+		Name:     encounter.Pokemon.Name,
+		Versions: make([]string, 0, len(encounter.VersionDetails)),
+	}
+
+	for _, version := range encounter.VersionDetails {
+		result.Versions = append(result.Versions, version.Version.Name)
+
+		for _, detail := range version.EncounterDetails {
+			if result.MinLevel == 0 || detail.MinLevel < result.MinLevel {
+				result.MinLevel = detail.MinLevel
+			}
+			if detail.MaxLevel > result.MaxLevel {
+				result.MaxLevel = detail.MaxLevel
+			}
+		}
+	}
+
+	return result
+}
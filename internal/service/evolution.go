@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// GetEvolution retrieves the evolution chain a Pokemon belongs to.
+// The chain is found by following its species' evolution_chain link.
+func (s *PokemonService) GetEvolution(ctx context.Context, nameOrID string) (*domain.EvolutionChain, error) {
+	pokemon, err := s.GetByName(ctx, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	speciesName := speciesOf(pokemon)
+
+	s.logger.Info("Getting evolution chain",
+		zap.String("species", speciesName),
+	)
+
+	species, err := s.client.FetchSpecies(ctx, speciesName)
+	if err != nil {
+		s.logger.Error("Failed to get species",
+			zap.String("species", speciesName),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	chainID := species.EvolutionChain.ID()
+	if chainID == 0 {
+		s.logger.Error("Species has no evolution chain",
+			zap.String("species", speciesName),
+			zap.String("url", species.EvolutionChain.URL),
+		)
+		return nil, fmt.Errorf("%w: species %s has no evolution chain", domain.ErrExternalAPI, speciesName)
+	}
+
+	chain, err := s.client.FetchEvolutionChain(ctx, chainID)
+	if err != nil {
+		s.logger.Error("Failed to get evolution chain",
+			zap.String("species", speciesName),
+			zap.Int("chain_id", chainID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return chain, nil
+}
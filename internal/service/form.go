@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// regionalForms are the regions whose name marks a regional variety, e.g. "vulpix-alola"
+var regionalForms = map[string]bool{
+	"alola":  true,
+	"galar":  true,
+	"hisui":  true,
+	"paldea": true,
+}
+
+// GetForms retrieves every variety of a Pokemon's species with its types and stats, and the forms of each.
+// Varieties and forms are fetched concurrently.
+func (s *PokemonService) GetForms(ctx context.Context, nameOrID string) (*domain.PokemonForms, error) {
+	pokemon, err := s.GetByName(ctx, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Getting forms",
+		zap.String("pokemon", pokemon.Name),
+	)
+
+	species, varieties, err := s.fetchVarieties(ctx, pokemon)
+	if err != nil {
+		s.logger.Error("Failed to get forms",
+			zap.String("pokemon", pokemon.Name),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	var formNames []string
+	for _, variety := range varieties {
+		formNames = append(formNames, resourceNames(variety.Forms)...)
+	}
+
+	forms, err := fetchAll(ctx, formNames, s.client.FetchForm)
+	if err != nil {
+		s.logger.Error("Failed to get forms",
+			zap.String("pokemon", pokemon.Name),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	result := &domain.PokemonForms{
+		Species:   species.Name,
+		Varieties: make([]domain.Variety, 0, len(varieties)),
+	}
+
+	// Forms were fetched in the order the varieties list them
+	next := 0
+	for i, variety := range varieties {
+		isDefault := species.Varieties[i].IsDefault
+
+		summary := domain.Variety{
+			Name:      variety.Name,
+			IsDefault: isDefault,
+			Kind:      varietyKind(species.Name, variety.Name, isDefault),
+			Types:     typeNames(variety.Types),
+			Stats:     baseStats(variety.Stats),
+			Forms:     make([]domain.Form, 0, len(variety.Forms)),
+		}
+
+		for _, form := range forms[next : next+len(variety.Forms)] {
+			summary.Forms = append(summary.Forms, domain.Form{
+				Name:         form.Name,
+				FormName:     form.FormName,
+				IsDefault:    form.IsDefault,
+				IsBattleOnly: form.IsBattleOnly,
+				IsMega:       form.IsMega,
+				VersionGroup: form.VersionGroup.Name,
+				Types:        typeNames(form.Types),
+			})
+		}
+		next += len(variety.Forms)
+
+		result.Varieties = append(result.Varieties, summary)
+	}
+
+	return result, nil
+}
+
+// ListAlternateForms lists the other varieties of a Pokemon's species, with their types and stats, on the Pokemon.
+// The Pokemon itself is not fetched again, so a species with a single variety costs one lookup.
+func (s *PokemonService) ListAlternateForms(ctx context.Context, pokemon *domain.Pokemon) error {
+	species, err := s.client.FetchSpecies(ctx, speciesOf(pokemon))
+	if err != nil {
+		return err
+	}
+
+	var others []domain.SpeciesVariety
+	for _, variety := range species.Varieties {
+		if variety.Pokemon.Name != pokemon.Name {
+			others = append(others, variety)
+		}
+	}
+
+	names := make([]string, 0, len(others))
+	for _, variety := range others {
+		names = append(names, variety.Pokemon.Name)
+	}
+
+	varieties, err := fetchAll(ctx, names, s.client.FetchPokemon)
+	if err != nil {
+		return err
+	}
+
+	pokemon.AlternateForms = make([]domain.AlternateForm, 0, len(others))
+	for i, variety := range varieties {
+		pokemon.AlternateForms = append(pokemon.AlternateForms, domain.AlternateForm{
+			Name:  others[i].Pokemon.Name,
+			URL:   others[i].Pokemon.URL,
+			Kind:  varietyKind(species.Name, others[i].Pokemon.Name, others[i].IsDefault),
+			Types: typeNames(variety.Types),
+			Stats: baseStats(variety.Stats),
+		})
+	}
+
+	return nil
+}
+
+// fetchVarieties fetches a Pokemon's species and every Pokemon in it, in the order the species lists them
+func (s *PokemonService) fetchVarieties(ctx context.Context, pokemon *domain.Pokemon) (*domain.Species, []*domain.Pokemon, error) {
+	species, err := s.client.FetchSpecies(ctx, speciesOf(pokemon))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names := make([]string, 0, len(species.Varieties))
+	for _, variety := range species.Varieties {
+		names = append(names, variety.Pokemon.Name)
+	}
+
+	varieties, err := fetchAll(ctx, names, s.client.FetchPokemon)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return species, varieties, nil
+}
+
+// varietyKind classifies a variety of a species from its name, e.g. "charizard-mega-x" or "meowth-galar".
+// Pikachu's "alola-cap" is a cosmetic cap, not a regional form.
+func varietyKind(species, name string, isDefault bool) domain.FormKind {
+	if isDefault {
+		return domain.FormDefault
+	}
+
+	suffix := strings.TrimPrefix(name, species+"-")
+	first, _, _ := strings.Cut(suffix, "-")
+
+	switch {
+	case first == "mega":
+		return domain.FormMega
+	case strings.HasSuffix(suffix, "gmax"):
+		return domain.FormGigantamax
+	case regionalForms[first] && !strings.HasSuffix(suffix, "-cap"):
+		return domain.FormRegional
+	default:
+		return domain.FormOther
+	}
+}
+
+// typeNames returns the names of a Pokemon's types
+func typeNames(types []domain.PokemonType) []string {
+	names := make([]string, 0, len(types))
+	for _, pokemonType := range types {
+		names = append(names, pokemonType.Type.Name)
+	}
+	return names
+}
+
+// baseStats maps each stat name to its base value
+func baseStats(stats []domain.Stat) map[string]int {
+	result := make(map[string]int, len(stats))
+	for _, stat := range stats {
+		result[stat.Stat.Name] = stat.BaseStat
+	}
+	return result
+}
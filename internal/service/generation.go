@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// ListGenerations retrieves a page of generations
+func (s *PokemonService) ListGenerations(ctx context.Context, page, limit int) (*domain.ResourceList, error) {
+	return s.listResources(ctx, "generation", page, limit)
+}
+
+// GetGeneration retrieves a generation and the species, types, moves and abilities it introduced
+func (s *PokemonService) GetGeneration(ctx context.Context, name string) (*domain.GenerationSummary, error) {
+	// Validate input
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("%w: generation name cannot be empty", domain.ErrInvalidInput)
+	}
+
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	s.logger.Info("Getting generation",
+		zap.String("name", name),
+	)
+
+	generation, err := s.client.FetchGeneration(ctx, name)
+	if err != nil {
+		s.logger.Error("Failed to get generation",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	// The PokeAPI lists species in no particular order
+	species := slices.Clone(generation.PokemonSpecies)
+	if species == nil {
+		species = []domain.NamedResource{}
+	}
+	slices.SortStableFunc(species, func(a, b domain.NamedResource) int {
+		return a.ID() - b.ID()
+	})
+
+	return &domain.GenerationSummary{
+		ID:            generation.ID,
+		Name:          generation.Name,
+		MainRegion:    generation.MainRegion.Name,
+		VersionGroups: resourceNames(generation.VersionGroups),
+		Species:       species,
+		Types:         resourceNames(generation.Types),
+		Moves:         resourceNames(generation.Moves),
+		Abilities:     resourceNames(generation.Abilities),
+	}, nil
+}
+
+// ListRegions retrieves a page of regions
+func (s *PokemonService) ListRegions(ctx context.Context, page, limit int) (*domain.ResourceList, error) {
+	return s.listResources(ctx, "region", page, limit)
+}
+
+// GetRegion retrieves a region with its Pokedexes, locations and games
+func (s *PokemonService) GetRegion(ctx context.Context, name string) (*domain.RegionSummary, error) {
+	// Validate input
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("%w: region name cannot be empty", domain.ErrInvalidInput)
+	}
+
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	s.logger.Info("Getting region",
+		zap.String("name", name),
+	)
+
+	region, err := s.client.FetchRegion(ctx, name)
+	if err != nil {
+		s.logger.Error("Failed to get region",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	summary := &domain.RegionSummary{
+		ID:            region.ID,
+		Name:          region.Name,
+		Pokedexes:     resourceNames(region.Pokedexes),
+		VersionGroups: resourceNames(region.VersionGroups),
+		Locations:     resourceNames(region.Locations),
+	}
+	if region.MainGeneration != nil {
+		summary.MainGeneration = region.MainGeneration.Name
+	}
+
+	return summary, nil
+}
+
+// ListPokedexes retrieves a page of Pokedexes
+func (s *PokemonService) ListPokedexes(ctx context.Context, page, limit int) (*domain.ResourceList, error) {
+	return s.listResources(ctx, "pokedex", page, limit)
+}
+
+// GetPokedex retrieves a Pokedex with a page of its entries, ordered by entry number.
+// The whole Pokedex is fetched once and cached; pages are sliced from it.
+func (s *PokemonService) GetPokedex(ctx context.Context, name string, page, limit int) (*domain.Pokedex, error) {
+	// Validate input
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("%w: pokedex name cannot be empty", domain.ErrInvalidInput)
+	}
+	if err := validatePagination(page, limit); err != nil {
+		return nil, err
+	}
+
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	s.logger.Info("Getting pokedex",
+		zap.String("name", name),
+		zap.Int("page", page),
+		zap.Int("limit", limit),
+	)
+
+	pokedex, err := s.client.FetchPokedex(ctx, name)
+	if err != nil {
+		s.logger.Error("Failed to get pokedex",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	entries := slices.Clone(pokedex.PokemonEntries)
+	slices.SortStableFunc(entries, func(a, b domain.PokedexEntry) int {
+		return a.EntryNumber - b.EntryNumber
+	})
+
+	start := min((page-1)*limit, len(entries))
+	end := min(start+limit, len(entries))
+
+	result := &domain.Pokedex{
+		ID:            pokedex.ID,
+		Name:          pokedex.Name,
+		IsMainSeries:  pokedex.IsMainSeries,
+		VersionGroups: resourceNames(pokedex.VersionGroups),
+		Entries:       make([]domain.PokedexEntrySummary, 0, end-start),
+		Total:         len(entries),
+		Page:          page,
+		PageSize:      limit,
+	}
+	if pokedex.Region != nil {
+		result.Region = pokedex.Region.Name
+	}
+
+	for _, entry := range entries[start:end] {
+		result.Entries = append(result.Entries, domain.PokedexEntrySummary{
+			EntryNumber: entry.EntryNumber,
+			Species:     entry.PokemonSpecies.Name,
+		})
+	}
+
+	return result, nil
+}
+
+// resourceNames returns the names of resources, never returning a nil slice
+func resourceNames(resources []domain.NamedResource) []string {
+	names := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		names = append(names, resource.Name)
+	}
+	return names
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+
+	// Registers the GIF decoder for image.Decode
+	_ "image/gif"
+)
+
+// Sprite output formats
+const (
+	formatPNG  = "png"
+	formatJPEG = "jpeg"
+)
+
+const (
+	// jpegQuality is the quality of re-encoded JPEG sprites
+	jpegQuality = 90
+
+	// maxImagePixels bounds the dimensions of an image that is decoded, so a
+	// small file declaring a huge image cannot exhaust memory
+	maxImagePixels = 2048 * 2048
+)
+
+// renderImage decodes a PNG, JPEG or GIF image, scales it to fit within a size x size
+// square (keeping its aspect ratio, 0 keeps the original size) and encodes it as format.
+// Images larger than maxImagePixels are rejected before they are decoded.
+func renderImage(data []byte, size int, format string) ([]byte, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width > maxImagePixels/config.Height {
+		return nil, fmt.Errorf("image of %dx%d pixels exceeds %d pixels", config.Width, config.Height, maxImagePixels)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	var img image.Image = src
+	if size > 0 {
+		width, height := fitWithin(src.Bounds().Dx(), src.Bounds().Dy(), size)
+		img = resize(src, width, height)
+	}
+
+	var out bytes.Buffer
+	switch format {
+	case formatJPEG:
+		// JPEG has no alpha channel, so transparent pixels become white
+		flat := image.NewRGBA(img.Bounds())
+		draw.Draw(flat, flat.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+		draw.Draw(flat, flat.Bounds(), img, img.Bounds().Min, draw.Over)
+		err = jpeg.Encode(&out, flat, &jpeg.Options{Quality: jpegQuality})
+	default:
+		err = png.Encode(&out, img)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+
+	return out.Bytes(), nil
+}
+
+// fitWithin scales width and height so the longer side is size, keeping the aspect ratio
+func fitWithin(width, height, size int) (int, int) {
+	if width <= 0 || height <= 0 {
+		return size, size
+	}
+
+	if width >= height {
+		return size, max(1, height*size/width)
+	}
+	return max(1, width*size/height), size
+}
+
+// resize scales src to width x height. Each destination pixel averages the source
+// pixels it covers, which reduces to nearest-neighbour when enlarging and keeps
+// pixel-art sprites sharp.
+func resize(src image.Image, width, height int) *image.NRGBA {
+	bounds := src.Bounds()
+	srcWidth, srcHeight := bounds.Dx(), bounds.Dy()
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		y0 := y * srcHeight / height
+		y1 := max(y0+1, (y+1)*srcHeight/height)
+
+		for x := 0; x < width; x++ {
+			x0 := x * srcWidth / width
+			x1 := max(x0+1, (x+1)*srcWidth/width)
+
+			// Average in premultiplied alpha so transparent pixels don't darken edges
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := src.At(bounds.Min.X+sx, bounds.Min.Y+sy).RGBA()
+					r += uint64(pr)
+					g += uint64(pg)
+					b += uint64(pb)
+					a += uint64(pa)
+					n++
+				}
+			}
+
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r / n),
+				G: uint16(g / n),
+				B: uint16(b / n),
+				A: uint16(a / n),
+			})
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// GetItem retrieves an item with its effect text in the most preferred of languages, falling back to DefaultLanguage
+func (s *PokemonService) GetItem(ctx context.Context, name string, languages []string) (*domain.ItemSummary, error) {
+	// Validate input
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("%w: item name cannot be empty", domain.ErrInvalidInput)
+	}
+
+	name = strings.ToLower(strings.TrimSpace(name))
+	languages = normalizeLanguages(languages)
+
+	s.logger.Info("Getting item",
+		zap.String("name", name),
+		zap.Strings("languages", languages),
+	)
+
+	item, err := s.client.FetchItem(ctx, name)
+	if err != nil {
+		s.logger.Error("Failed to get item",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return summarizeItem(item, languages), nil
+}
+
+// GetBerry retrieves a berry and the item it is, with effect text in the most preferred of languages.
+// Cost, effect and sprite belong to the berry's item, which is fetched as well.
+func (s *PokemonService) GetBerry(ctx context.Context, name string, languages []string) (*domain.BerrySummary, error) {
+	// Validate input
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("%w: berry name cannot be empty", domain.ErrInvalidInput)
+	}
+
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	s.logger.Info("Getting berry",
+		zap.String("name", name),
+	)
+
+	berry, err := s.client.FetchBerry(ctx, name)
+	if err != nil {
+		s.logger.Error("Failed to get berry",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	item, err := s.GetItem(ctx, berry.Item.Name, languages)
+	if err != nil {
+		return nil, err
+	}
+
+	flavors := make(map[string]int, len(berry.Flavors))
+	for _, flavor := range berry.Flavors {
+		flavors[flavor.Flavor.Name] = flavor.Potency
+	}
+
+	return &domain.BerrySummary{
+		ID:               berry.ID,
+		Name:             berry.Name,
+		Firmness:         berry.Firmness.Name,
+		GrowthTime:       berry.GrowthTime,
+		MaxHarvest:       berry.MaxHarvest,
+		Size:             berry.Size,
+		Smoothness:       berry.Smoothness,
+		SoilDryness:      berry.SoilDryness,
+		NaturalGiftPower: berry.NaturalGiftPower,
+		NaturalGiftType:  berry.NaturalGiftType.Name,
+		Flavors:          flavors,
+		Item:             item,
+	}, nil
+}
+
+// summarizeItem projects an item onto the most preferred of languages
+func summarizeItem(item *domain.ItemDetails, languages []string) *domain.ItemSummary {
+	summary := &domain.ItemSummary{
+		ID:            item.ID,
+		Name:          item.Name,
+		Cost:          item.Cost,
+		Category:      item.Category.Name,
+		Attributes:    make([]string, 0, len(item.Attributes)),
+		FlingPower:    item.FlingPower,
+		Sprite:        item.Sprites.Default,
+		Effect:        domain.LocalizeEffect(item.EffectEntries, languages),
+		HeldByPokemon: item.HeldByPokemon,
+	}
+
+	for _, attribute := range item.Attributes {
+		summary.Attributes = append(summary.Attributes, attribute.Name)
+	}
+	if item.FlingEffect != nil {
+		summary.FlingEffect = item.FlingEffect.Name
+	}
+	if summary.HeldByPokemon == nil {
+		summary.HeldByPokemon = []domain.ItemHolder{}
+	}
+
+	return summary
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// localizeMovesConcurrency caps the moves fetched at once to localize their names,
+// as a Pokemon can learn over a hundred
+const localizeMovesConcurrency = 8
+
+// normalizeLanguages lowercases languages and expands them into a fallback chain.
+// Each language is followed by its primary subtag, so "es-mx" falls back to "es"
+// before the next preference, and the chain always ends with DefaultLanguage.
+func normalizeLanguages(languages []string) []string {
+	chain := make([]string, 0, len(languages)*2+1)
+	add := func(language string) {
+		if language != "" && !slices.Contains(chain, language) {
+			chain = append(chain, language)
+		}
+	}
+
+	for _, language := range languages {
+		language = strings.ToLower(strings.TrimSpace(language))
+		add(language)
+		if primary, _, found := strings.Cut(language, "-"); found {
+			add(primary)
+		}
+	}
+	add(DefaultLanguage)
+
+	return chain
+}
+
+// LocalizePokemon names a Pokemon, its types, its abilities and its moves in the most preferred of languages.
+// The Pokemon's name comes from its species, which alternate forms share.
+// Nothing is localized unless every name could be fetched.
+func (s *PokemonService) LocalizePokemon(ctx context.Context, pokemon *domain.Pokemon, languages []string) error {
+	languages = normalizeLanguages(languages)
+
+	s.logger.Info("Localizing Pokemon",
+		zap.String("name", pokemon.Name),
+		zap.Strings("languages", languages),
+	)
+
+	species, err := s.client.FetchSpecies(ctx, speciesOf(pokemon))
+	if err != nil {
+		s.logger.Error("Failed to localize Pokemon",
+			zap.String("name", pokemon.Name),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	types, err := fetchAll(ctx, typeNames(pokemon.Types), s.client.FetchType)
+	if err != nil {
+		s.logger.Error("Failed to localize Pokemon types",
+			zap.String("name", pokemon.Name),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	abilities, err := fetchAll(ctx, abilityNames(pokemon.Abilities), s.client.FetchAbility)
+	if err != nil {
+		s.logger.Error("Failed to localize Pokemon abilities",
+			zap.String("name", pokemon.Name),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	moves, err := s.localizeMoveNames(ctx, moveNames(pokemon.Moves), languages)
+	if err != nil {
+		s.logger.Error("Failed to localize Pokemon moves",
+			zap.String("name", pokemon.Name),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	pokemon.LocalizedName = domain.LocalizeName(species.Names, languages)
+	for i, details := range types {
+		pokemon.Types[i].Type.LocalizedName = domain.LocalizeName(details.Names, languages)
+	}
+	for i, details := range abilities {
+		pokemon.Abilities[i].Ability.LocalizedName = domain.LocalizeName(details.Names, languages)
+	}
+	for i := range pokemon.Moves {
+		pokemon.Moves[i].Move.LocalizedName = moves[pokemon.Moves[i].Move.Name]
+	}
+
+	return nil
+}
+
+// LocalizeMoves names the moves of a moveset in the most preferred of languages.
+// Nothing is localized unless every name could be fetched.
+func (s *PokemonService) LocalizeMoves(ctx context.Context, moves *domain.PokemonMoves, languages []string) error {
+	languages = normalizeLanguages(languages)
+
+	var names []string
+	for _, move := range moves.Moves {
+		if !slices.Contains(names, move.Name) {
+			names = append(names, move.Name)
+		}
+	}
+
+	s.logger.Info("Localizing Pokemon moves",
+		zap.String("pokemon", moves.Pokemon),
+		zap.Int("moves", len(names)),
+		zap.Strings("languages", languages),
+	)
+
+	localized, err := s.localizeMoveNames(ctx, names, languages)
+	if err != nil {
+		s.logger.Error("Failed to localize Pokemon moves",
+			zap.String("pokemon", moves.Pokemon),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	for i := range moves.Moves {
+		moves.Moves[i].LocalizedName = localized[moves.Moves[i].Name]
+	}
+
+	return nil
+}
+
+// localizeMoveNames fetches the named moves and returns their names in the most
+// preferred of the normalized languages, keyed by move name
+func (s *PokemonService) localizeMoveNames(ctx context.Context, names []string, languages []string) (map[string]*domain.LocalizedName, error) {
+	moves, err := fetchAllLimit(ctx, names, localizeMovesConcurrency, s.client.FetchMove)
+	if err != nil {
+		return nil, err
+	}
+
+	localized := make(map[string]*domain.LocalizedName, len(moves))
+	for i, details := range moves {
+		localized[names[i]] = domain.LocalizeName(details.Names, languages)
+	}
+	return localized, nil
+}
+
+// moveNames returns the names of a Pokemon's moves in the order it lists them
+func moveNames(moves []domain.PokemonMove) []string {
+	names := make([]string, 0, len(moves))
+	for _, move := range moves {
+		names = append(names, move.Move.Name)
+	}
+	return names
+}
+
+// abilityNames returns the names of a Pokemon's abilities in slot order
+func abilityNames(abilities []domain.Ability) []string {
+	names := make([]string, 0, len(abilities))
+	for _, ability := range abilities {
+		names = append(names, ability.Ability.Name)
+	}
+	return names
+}
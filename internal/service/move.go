@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// effectChancePlaceholder is replaced by a move's effect chance in its effect text
+const effectChancePlaceholder = "$effect_chance"
+
+// GetMoves retrieves the moves a Pokemon can learn, narrowed by filter.
+// Each way of learning a move is listed separately, ordered by learn method,
+// level, name and version group.
+func (s *PokemonService) GetMoves(ctx context.Context, nameOrID string, filter domain.MoveFilter) (*domain.PokemonMoves, error) {
+	pokemon, err := s.GetByName(ctx, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.VersionGroup = strings.ToLower(strings.TrimSpace(filter.VersionGroup))
+	filter.Method = strings.ToLower(strings.TrimSpace(filter.Method))
+
+	moves := []domain.LearnableMove{}
+	for _, move := range pokemon.Moves {
+		for _, detail := range move.VersionGroupDetails {
+			if filter.VersionGroup != "" && detail.VersionGroup.Name != filter.VersionGroup {
+				continue
+			}
+			if filter.Method != "" && detail.MoveLearnMethod.Name != filter.Method {
+				continue
+			}
+
+			moves = append(moves, domain.LearnableMove{
+				Name:         move.Move.Name,
+				Method:       detail.MoveLearnMethod.Name,
+				Level:        detail.LevelLearnedAt,
+				VersionGroup: detail.VersionGroup.Name,
+			})
+		}
+	}
+
+	sort.SliceStable(moves, func(i, j int) bool {
+		a, b := moves[i], moves[j]
+		if a.Method != b.Method {
+			return a.Method < b.Method
+		}
+		if a.Level != b.Level {
+			return a.Level < b.Level
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.VersionGroup < b.VersionGroup
+	})
+
+	return &domain.PokemonMoves{
+		Pokemon:      pokemon.Name,
+		VersionGroup: filter.VersionGroup,
+		Method:       filter.Method,
+		Moves:        moves,
+	}, nil
+}
+
+// GetMove retrieves a move with its name and effect text in the most preferred of languages, falling back to DefaultLanguage
+func (s *PokemonService) GetMove(ctx context.Context, name string, languages []string) (*domain.MoveSummary, error) {
+	// Validate input
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("%w: move name cannot be empty", domain.ErrInvalidInput)
+	}
+
+	name = strings.ToLower(strings.TrimSpace(name))
+	languages = normalizeLanguages(languages)
+
+	s.logger.Info("Getting move",
+		zap.String("name", name),
+		zap.Strings("languages", languages),
+	)
+
+	move, err := s.client.FetchMove(ctx, name)
+	if err != nil {
+		s.logger.Error("Failed to get move",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	effect := domain.LocalizeEffect(move.EffectEntries, languages)
+	if move.EffectChance != nil {
+		chance := strconv.Itoa(*move.EffectChance)
+		effect.Effect = strings.ReplaceAll(effect.Effect, effectChancePlaceholder, chance)
+		effect.ShortEffect = strings.ReplaceAll(effect.ShortEffect, effectChancePlaceholder, chance)
+	}
+
+	return &domain.MoveSummary{
+		ID:            move.ID,
+		Name:          move.Name,
+		LocalizedName: domain.LocalizeName(move.Names, languages),
+		Type:          move.Type.Name,
+		DamageClass:   move.DamageClass.Name,
+		Power:         move.Power,
+		Accuracy:      move.Accuracy,
+		PP:            move.PP,
+		Priority:      move.Priority,
+		EffectChance:  move.EffectChance,
+		Target:        move.Target.Name,
+		Generation:    move.Generation.Name,
+		Effect:        effect,
+	}, nil
+}
@@ -3,24 +3,37 @@ package service
 import (
 	"context"
 	"fmt"
+	"math"
 	"strings"
 
+	"github.com/polgarcia/golang-rest-api/internal/cache"
 	"github.com/polgarcia/golang-rest-api/internal/domain"
 	"github.com/polgarcia/golang-rest-api/pkg/logger"
 	"go.uber.org/zap"
 )
 
+// MaxPageSize is the maximum number of items returned by a list request
+const MaxPageSize = 100
+
+// MaxPage is the highest page number a list request accepts, so that offsets never overflow
+const MaxPage = math.MaxInt / MaxPageSize
+
 // PokemonService implements the domain.PokemonService interface
 type PokemonService struct {
-	client domain.PokemonClient
-	logger *logger.Logger
+	client  domain.PokemonClient
+	sprites cache.Store
+	renders renderGroup
+	stats   *statIndex
+	logger  *logger.Logger
 }
 
 // NewPokemonService creates a new Pokemon service
 func NewPokemonService(client domain.PokemonClient, log *logger.Logger) *PokemonService {
 	return &PokemonService{
-		client: client,
-		logger: log,
+		client:  client,
+		sprites: cache.NewMemoryBytes(spriteCacheBytes),
+		stats:   &statIndex{},
+		logger:  log,
 	}
 }
 
@@ -68,3 +81,79 @@ func (s *PokemonService) GetCount(ctx context.Context) (*domain.PokemonCount, er
 
 	return &domain.PokemonCount{Count: count}, nil
 }
+
+// List retrieves a page of Pokemon
+func (s *PokemonService) List(ctx context.Context, page, limit int) (*domain.ResourceList, error) {
+	// Validate input
+	if err := validatePagination(page, limit); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Listing Pokemon",
+		zap.Int("page", page),
+		zap.Int("limit", limit),
+	)
+
+	items, total, err := s.client.FetchPokemonList(ctx, (page-1)*limit, limit)
+	if err != nil {
+		s.logger.Error("Failed to list Pokemon",
+			zap.Int("page", page),
+			zap.Int("limit", limit),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return newResourceList(items, total, page, limit), nil
+}
+
+// listResources retrieves a page of any named resource listing, such as "type"
+func (s *PokemonService) listResources(ctx context.Context, endpoint string, page, limit int) (*domain.ResourceList, error) {
+	if err := validatePagination(page, limit); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Listing resources",
+		zap.String("endpoint", endpoint),
+		zap.Int("page", page),
+		zap.Int("limit", limit),
+	)
+
+	items, total, err := s.client.FetchResourceList(ctx, endpoint, (page-1)*limit, limit)
+	if err != nil {
+		s.logger.Error("Failed to list resources",
+			zap.String("endpoint", endpoint),
+			zap.Int("page", page),
+			zap.Int("limit", limit),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return newResourceList(items, total, page, limit), nil
+}
+
+// validatePagination checks the page and limit of a list request
+func validatePagination(page, limit int) error {
+	if limit < 1 || limit > MaxPageSize {
+		return fmt.Errorf("%w: invalid limit: must be between 1 and %d", domain.ErrInvalidInput, MaxPageSize)
+	}
+	if page < 1 || page > MaxPage {
+		return fmt.Errorf("%w: invalid page: must be between 1 and %d", domain.ErrInvalidInput, MaxPage)
+	}
+	return nil
+}
+
+// newResourceList builds a page of results, never returning a nil item slice
+func newResourceList(items []domain.NamedResource, total, page, limit int) *domain.ResourceList {
+	if items == nil {
+		items = []domain.NamedResource{}
+	}
+
+	return &domain.ResourceList{
+		Items:    items,
+		Total:    total,
+		Page:     page,
+		PageSize: limit,
+	}
+}
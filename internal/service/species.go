@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// DefaultLanguage is the language used for localized text when none is requested
+const DefaultLanguage = domain.DefaultLanguage
+
+// genderlessRate is the PokeAPI gender_rate of species without a gender
+const genderlessRate = -1
+
+// GetSpecies retrieves the species of a Pokemon, localized by filter.
+// The Pokemon is resolved first so alternate forms such as "raichu-alola"
+// map to their species.
+func (s *PokemonService) GetSpecies(ctx context.Context, nameOrID string, filter domain.SpeciesFilter) (*domain.SpeciesSummary, error) {
+	pokemon, err := s.GetByName(ctx, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.Languages = normalizeLanguages(filter.Languages)
+	filter.Version = strings.ToLower(strings.TrimSpace(filter.Version))
+
+	speciesName := speciesOf(pokemon)
+
+	s.logger.Info("Getting species",
+		zap.String("species", speciesName),
+		zap.Strings("languages", filter.Languages),
+		zap.String("version", filter.Version),
+	)
+
+	species, err := s.client.FetchSpecies(ctx, speciesName)
+	if err != nil {
+		s.logger.Error("Failed to get species",
+			zap.String("species", speciesName),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return summarizeSpecies(species, filter), nil
+}
+
+// summarizeSpecies projects a species onto the languages and version in filter.
+// Flavor text is listed in a single language, the most preferred one it has been written in.
+func summarizeSpecies(species *domain.Species, filter domain.SpeciesFilter) *domain.SpeciesSummary {
+	language := DefaultLanguage
+	if entry := domain.Localize(species.FlavorTextEntries, func(entry *domain.FlavorTextEntry) string {
+		return entry.Language.Name
+	}, filter.Languages); entry != nil {
+		language = entry.Language.Name
+	}
+
+	summary := &domain.SpeciesSummary{
+		ID:            species.ID,
+		Name:          species.Name,
+		LocalizedName: domain.LocalizeName(species.Names, filter.Languages),
+		Language:      language,
+		FlavorText:    flavorText(species.FlavorTextEntries, language, filter.Version),
+		CaptureRate:   species.CaptureRate,
+		BaseHappiness: species.BaseHappiness,
+		GenderRatio:   genderRatio(species.GenderRate),
+		IsBaby:        species.IsBaby,
+		IsLegendary:   species.IsLegendary,
+		IsMythical:    species.IsMythical,
+		Generation:    species.Generation.Name,
+		GrowthRate:    species.GrowthRate.Name,
+		Color:         species.Color.Name,
+	}
+
+	if genus := domain.Localize(species.Genera, func(genus *domain.Genus) string {
+		return genus.Language.Name
+	}, filter.Languages); genus != nil {
+		summary.Genus = genus.Genus
+	}
+
+	if species.Habitat != nil {
+		summary.Habitat = species.Habitat.Name
+	}
+	if species.EvolvesFromSpecies != nil {
+		summary.EvolvesFrom = species.EvolvesFromSpecies.Name
+	}
+
+	return summary
+}
+
+// flavorText returns the entries in language, and version if set, with identical texts merged.
+// Many games reuse the same Pokédex entry, so each text is listed once along
+// with every version it appears in, in upstream order.
+func flavorText(entries []domain.FlavorTextEntry, language, version string) []domain.FlavorText {
+	texts := []domain.FlavorText{}
+	index := make(map[string]int)
+
+	for _, entry := range entries {
+		if entry.Language.Name != language {
+			continue
+		}
+		if version != "" && entry.Version.Name != version {
+			continue
+		}
+
+		text := cleanFlavorText(entry.FlavorText)
+		if i, ok := index[text]; ok {
+			texts[i].Versions = append(texts[i].Versions, entry.Version.Name)
+			continue
+		}
+
+		index[text] = len(texts)
+		texts = append(texts, domain.FlavorText{
+			Text:     text,
+			Versions: []string{entry.Version.Name},
+		})
+	}
+
+	return texts
+}
+
+// cleanFlavorText removes the line and page breaks the games use for layout
+func cleanFlavorText(text string) string {
+	// A soft hyphen before a line break joins a word split across lines
+	text = strings.ReplaceAll(text, "\u00ad\n", "")
+	text = strings.ReplaceAll(text, "\u00ad", "")
+
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// genderRatio converts a gender rate in eighths female into percentages
+func genderRatio(rate int) *domain.GenderRatio {
+	if rate == genderlessRate {
+		return nil
+	}
+
+	female := float64(rate) * 100 / 8
+	return &domain.GenderRatio{
+		Male:   100 - female,
+		Female: female,
+	}
+}
+
+// speciesOf returns the species name of a Pokemon, which differs from its own name for alternate forms
+func speciesOf(pokemon *domain.Pokemon) string {
+	if pokemon.Species.Name == "" {
+		return pokemon.Name
+	}
+	return pokemon.Species.Name
+}
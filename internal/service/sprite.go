@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/bits"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+const (
+	// MaxSpriteSize is the largest width or height a sprite can be resized to
+	MaxSpriteSize = 1024
+
+	// spriteCacheBytes bounds the total size of the rendered sprites kept in memory
+	spriteCacheBytes = 64 << 20
+
+	// spriteCacheTTL is how long a rendered sprite is kept
+	spriteCacheTTL = 24 * time.Hour
+)
+
+// GetSprite retrieves one of a Pokemon's sprites, such as "front-default" or "official-artwork",
+// scaled to fit within size x size pixels (0 keeps the original size) and encoded as png or jpeg.
+// The size is rounded up to a power of two so that few distinct renders exist per sprite.
+// Rendered sprites are cached in memory, and concurrent requests for the same render share it.
+func (s *PokemonService) GetSprite(ctx context.Context, nameOrID, kind string, size int, format string) (*domain.Asset, error) {
+	// Validate input
+	format = strings.ToLower(strings.TrimSpace(format))
+	switch format {
+	case "":
+		format = formatPNG
+	case "jpg":
+		format = formatJPEG
+	case formatPNG, formatJPEG:
+	default:
+		return nil, fmt.Errorf("%w: invalid format %q: must be %s or %s", domain.ErrInvalidInput, format, formatPNG, formatJPEG)
+	}
+	if size < 0 || size > MaxSpriteSize {
+		return nil, fmt.Errorf("%w: invalid size: must be between 0 (original size) and %d", domain.ErrInvalidInput, MaxSpriteSize)
+	}
+	size = snapSpriteSize(size)
+
+	pokemon, err := s.GetByName(ctx, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := pokemon.Sprites.URL(kind)
+	if err != nil {
+		return nil, err
+	}
+	if url == "" {
+		return nil, domain.NotFound("sprite")
+	}
+
+	kind = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(kind)), "_", "-")
+
+	s.logger.Info("Getting sprite",
+		zap.String("pokemon", pokemon.Name),
+		zap.String("kind", kind),
+		zap.Int("size", size),
+		zap.String("format", format),
+	)
+
+	sprite := &domain.Asset{
+		Name:        fmt.Sprintf("%s-%s.%s", pokemon.Name, kind, format),
+		ContentType: "image/" + format,
+	}
+
+	key := fmt.Sprintf("%s|%d|%s", url, size, format)
+	if data, ok := s.sprites.Get(key); ok {
+		sprite.Data = data
+		return sprite, nil
+	}
+
+	sprite.Data, err = s.renders.Do(ctx, key, func(ctx context.Context) ([]byte, error) {
+		data, err := s.client.FetchAsset(ctx, url)
+		if err != nil {
+			s.logger.Error("Failed to get sprite",
+				zap.String("pokemon", pokemon.Name),
+				zap.String("kind", kind),
+				zap.Error(err),
+			)
+			if errors.Is(err, domain.ErrResourceNotFound) {
+				return nil, domain.NotFound("sprite")
+			}
+			return nil, err
+		}
+
+		rendered, err := renderImage(data, size, format)
+		if err != nil {
+			s.logger.Error("Failed to render sprite",
+				zap.String("pokemon", pokemon.Name),
+				zap.String("kind", kind),
+				zap.Error(err),
+			)
+			return nil, fmt.Errorf("%w: %v", domain.ErrExternalAPI, err)
+		}
+
+		s.sprites.Set(key, rendered, spriteCacheTTL)
+		return rendered, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sprite, nil
+}
+
+// snapSpriteSize rounds a requested sprite size up to the next power of two; 0 stays 0
+func snapSpriteSize(size int) int {
+	if size <= 1 {
+		return size
+	}
+	return 1 << bits.Len(uint(size-1))
+}
+
+// renderGroup shares a sprite render between concurrent requests for the same key
+type renderGroup struct {
+	mu    sync.Mutex
+	calls map[string]*renderCall
+}
+
+// renderCall is a sprite render in progress
+type renderCall struct {
+	done chan struct{}
+	data []byte
+	err  error
+}
+
+// Do runs render once for all concurrent callers using key and hands each of them the result.
+// render runs detached from the callers' cancellation, so one caller giving up does not fail
+// the others, and its result is still cached for the next request.
+func (g *renderGroup) Do(ctx context.Context, key string, render func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*renderCall)
+	}
+
+	call, ok := g.calls[key]
+	if !ok {
+		call = &renderCall{done: make(chan struct{})}
+		g.calls[key] = call
+
+		go func() {
+			call.data, call.err = render(context.WithoutCancel(ctx))
+
+			g.mu.Lock()
+			delete(g.calls, key)
+			g.mu.Unlock()
+
+			close(call.done)
+		}()
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.data, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
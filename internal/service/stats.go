@@ -0,0 +1,396 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+const (
+	// statIndexTTL is how long the base stat distribution of every Pokemon is used before it is rebuilt
+	statIndexTTL = 24 * time.Hour
+
+	// statIndexTimeout bounds a build of the distribution, which fetches every Pokemon
+	statIndexTimeout = 15 * time.Minute
+
+	// statIndexConcurrency bounds the Pokemon fetched at once while building the distribution
+	statIndexConcurrency = 16
+
+	// statIndexWait is how long a request waits for a build of the distribution before giving up
+	statIndexWait = 2 * time.Second
+
+	// statIndexRetryAfter is suggested to clients that give up waiting for the first build
+	statIndexRetryAfter = 30 * time.Second
+
+	// statIndexBackoff is how long a failed build holds off the next one
+	statIndexBackoff = 5 * time.Minute
+
+	// statIndexMaxSkipped is the fraction of Pokemon a build may fail to fetch and leave out
+	statIndexMaxSkipped = 0.1
+
+	// statIndexResource names the stat distribution in errors
+	statIndexResource = "stat distribution"
+
+	// totalStat keys the base stat total in a distribution
+	totalStat = "total"
+
+	// hpStat is the only stat natures do not affect
+	hpStat = "hp"
+
+	// maxIV and maxEV are the highest individual and effort values a single stat can have
+	maxIV = 31
+	maxEV = 252
+)
+
+// GetStats retrieves a Pokemon's base stats with their percentiles among every Pokemon,
+// rank within its primary type, and the values they reach at levels 50 and 100.
+// Percentiles need the stats of every Pokemon, which are fetched once and kept for statIndexTTL.
+func (s *PokemonService) GetStats(ctx context.Context, nameOrID string) (*domain.PokemonStats, error) {
+	pokemon, err := s.GetByName(ctx, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	primaryType := primaryTypeOf(pokemon)
+
+	s.logger.Info("Getting stats",
+		zap.String("name", pokemon.Name),
+		zap.String("primary_type", primaryType),
+	)
+
+	distribution, err := s.stats.get(ctx, s.buildStatDistribution)
+	if err != nil {
+		s.logger.Error("Failed to get stat distribution",
+			zap.String("name", pokemon.Name),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	var members []string
+	if primaryType != "" {
+		details, err := s.client.FetchType(ctx, primaryType)
+		if err != nil {
+			s.logger.Error("Failed to get stats",
+				zap.String("name", pokemon.Name),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+		// Pokemon that only have the type as their second type rank elsewhere
+		for _, member := range details.Pokemon {
+			if member.Slot == 1 {
+				members = append(members, member.Pokemon.Name)
+			}
+		}
+	}
+
+	total := 0
+	for _, stat := range pokemon.Stats {
+		total += stat.BaseStat
+	}
+
+	result := &domain.PokemonStats{
+		ID:          pokemon.ID,
+		Name:        pokemon.Name,
+		PrimaryType: primaryType,
+		Population:  distribution.population,
+		Total: domain.StatTotal{
+			Base:       total,
+			Percentile: distribution.percentile(totalStat, total),
+			TypeRank:   distribution.rank(totalStat, pokemon.Name, total, members),
+		},
+		Stats: make([]domain.StatAnalysis, 0, len(pokemon.Stats)),
+	}
+
+	for _, stat := range pokemon.Stats {
+		name := stat.Stat.Name
+		result.Stats = append(result.Stats, domain.StatAnalysis{
+			Name:       name,
+			Base:       stat.BaseStat,
+			Effort:     stat.Effort,
+			Percentile: distribution.percentile(name, stat.BaseStat),
+			TypeRank:   distribution.rank(name, pokemon.Name, stat.BaseStat, members),
+			Level50:    statRanges(name, stat.BaseStat, 50),
+			Level100:   statRanges(name, stat.BaseStat, 100),
+		})
+	}
+
+	return result, nil
+}
+
+// buildStatDistribution fetches every Pokemon and collects their base stats
+func (s *PokemonService) buildStatDistribution(ctx context.Context) (*statDistribution, error) {
+	start := time.Now()
+
+	// The build runs at a lower rate than user requests and its failures do not
+	// open the circuit breaker that user requests go through
+	ctx = domain.AsBackground(ctx)
+
+	count, err := s.client.FetchPokemonCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Building stat distribution",
+		zap.Int("count", count),
+	)
+
+	items, _, err := s.client.FetchPokemonList(ctx, 0, count)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every Pokemon is read once per build, so they bypass the response cache rather
+	// than evicting everything else from it. A few that cannot be fetched barely move
+	// the percentiles and are left out.
+	var skipped atomic.Int64
+	pokemon, err := fetchAllLimit(domain.WithoutCache(ctx), resourceNames(items), statIndexConcurrency,
+		func(ctx context.Context, name string) (*domain.Pokemon, error) {
+			p, err := s.client.FetchPokemon(ctx, name)
+			if err != nil && ctx.Err() == nil {
+				skipped.Add(1)
+				s.logger.Debug("Leaving Pokemon out of stat distribution",
+					zap.String("name", name),
+					zap.Error(err),
+				)
+				return nil, nil
+			}
+			return p, err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if float64(skipped.Load()) > float64(len(items))*statIndexMaxSkipped {
+		return nil, fmt.Errorf("%w: %d of %d Pokemon could not be fetched", domain.ErrExternalAPI, skipped.Load(), len(items))
+	}
+	pokemon = slices.DeleteFunc(pokemon, func(p *domain.Pokemon) bool { return p == nil })
+
+	s.logger.Info("Built stat distribution",
+		zap.Int("count", len(pokemon)),
+		zap.Int64("skipped", skipped.Load()),
+		zap.Duration("duration", time.Since(start)),
+	)
+
+	return newStatDistribution(pokemon), nil
+}
+
+// primaryTypeOf returns the name of a Pokemon's first type
+func primaryTypeOf(pokemon *domain.Pokemon) string {
+	if len(pokemon.Types) == 0 {
+		return ""
+	}
+
+	primary := slices.MinFunc(pokemon.Types, func(a, b domain.PokemonType) int {
+		return a.Slot - b.Slot
+	})
+	return primary.Type.Name
+}
+
+// statRanges computes the values a stat reaches at level under each kind of nature
+func statRanges(name string, base, level int) domain.StatRanges {
+	if name == hpStat {
+		return domain.StatRanges{
+			Neutral: domain.StatRange{
+				Min: computeHP(base, 0, 0, level),
+				Max: computeHP(base, maxIV, maxEV, level),
+			},
+		}
+	}
+
+	low := computeStat(base, 0, 0, level)
+	high := computeStat(base, maxIV, maxEV, level)
+
+	// Natures scale the stat by 10% either way, rounding down
+	return domain.StatRanges{
+		Hindering:  &domain.StatRange{Min: low * 9 / 10, Max: high * 9 / 10},
+		Neutral:    domain.StatRange{Min: low, Max: high},
+		Beneficial: &domain.StatRange{Min: low * 11 / 10, Max: high * 11 / 10},
+	}
+}
+
+// computeHP applies the HP formula used since Generation III.
+// Shedinja, the only Pokemon with a base HP of 1, always has 1 HP.
+func computeHP(base, iv, ev, level int) int {
+	if base == 1 {
+		return 1
+	}
+	return (2*base+iv+ev/4)*level/100 + level + 10
+}
+
+// computeStat applies the formula used since Generation III for every stat but HP, before the nature
+func computeStat(base, iv, ev, level int) int {
+	return (2*base+iv+ev/4)*level/100 + 5
+}
+
+// statDistribution holds the base stats of every Pokemon
+type statDistribution struct {
+	population int
+
+	// base maps each Pokemon to its base stats and total
+	base map[string]map[string]int
+
+	// sorted holds every Pokemon's value of each stat in ascending order
+	sorted map[string][]int
+}
+
+// newStatDistribution collects the base stats and totals of pokemon
+func newStatDistribution(pokemon []*domain.Pokemon) *statDistribution {
+	distribution := &statDistribution{
+		population: len(pokemon),
+		base:       make(map[string]map[string]int, len(pokemon)),
+		sorted:     make(map[string][]int),
+	}
+
+	for _, p := range pokemon {
+		stats := baseStats(p.Stats)
+		total := 0
+		for name, value := range stats {
+			total += value
+			distribution.sorted[name] = append(distribution.sorted[name], value)
+		}
+		stats[totalStat] = total
+		distribution.sorted[totalStat] = append(distribution.sorted[totalStat], total)
+		distribution.base[p.Name] = stats
+	}
+
+	for _, values := range distribution.sorted {
+		slices.Sort(values)
+	}
+
+	return distribution
+}
+
+// percentile returns the percentile rank of value among every Pokemon's stat, to one decimal.
+// Pokemon with an equal value count as half below it, so the median scores 50.
+func (d *statDistribution) percentile(stat string, value int) float64 {
+	values := d.sorted[stat]
+	if len(values) == 0 {
+		return 0
+	}
+
+	below, _ := slices.BinarySearch(values, value)
+	above, _ := slices.BinarySearch(values, value+1)
+	equal := above - below
+
+	percent := (float64(below) + float64(equal)/2) * 100 / float64(len(values))
+	return math.Round(percent*10) / 10
+}
+
+// rank returns where value places name among members, which need not include name.
+// Members missing from the distribution are skipped.
+func (d *statDistribution) rank(stat, name string, value int, members []string) domain.StatRank {
+	rank := domain.StatRank{Rank: 1, Of: 1}
+
+	for _, member := range members {
+		if member == name {
+			continue
+		}
+		other, ok := d.base[member][stat]
+		if !ok {
+			continue
+		}
+
+		rank.Of++
+		if other > value {
+			rank.Rank++
+		}
+	}
+
+	return rank
+}
+
+// statIndex builds the stat distribution on first use and rebuilds it every statIndexTTL.
+// Builds run detached from the requests waiting on them, so one completes even if the
+// request that started it gives up, and an expired distribution is served while it is rebuilt.
+// A failed build keeps the previous distribution and holds off the next one for statIndexBackoff.
+type statIndex struct {
+	mu           sync.Mutex
+	distribution *statDistribution
+	expires      time.Time
+	retryAt      time.Time
+	building     *statBuild
+}
+
+// statBuild is a build of the stat distribution in progress
+type statBuild struct {
+	done         chan struct{}
+	distribution *statDistribution
+	err          error
+}
+
+// get returns the stat distribution, building it if there is none yet.
+// If the first build does not complete within statIndexWait or before ctx ends, or the
+// last build failed and the next is held off, get returns a *domain.NotReadyError so
+// the client retries later.
+func (i *statIndex) get(ctx context.Context, build func(ctx context.Context) (*statDistribution, error)) (*statDistribution, error) {
+	i.mu.Lock()
+	now := time.Now()
+	canStart := i.building == nil && !now.Before(i.retryAt)
+	if i.distribution != nil {
+		if now.After(i.expires) && canStart {
+			i.building = i.start(build)
+		}
+		distribution := i.distribution
+		i.mu.Unlock()
+		return distribution, nil
+	}
+	if i.building == nil {
+		if !canStart {
+			retryAfter := i.retryAt.Sub(now)
+			i.mu.Unlock()
+			return nil, &domain.NotReadyError{Resource: statIndexResource, RetryAfter: retryAfter}
+		}
+		i.building = i.start(build)
+	}
+	current := i.building
+	i.mu.Unlock()
+
+	// A build fetches every Pokemon and can take minutes, far longer than a client should hang
+	timer := time.NewTimer(statIndexWait)
+	defer timer.Stop()
+
+	select {
+	case <-current.done:
+		return current.distribution, current.err
+	case <-timer.C:
+		return nil, &domain.NotReadyError{Resource: statIndexResource, RetryAfter: statIndexRetryAfter}
+	case <-ctx.Done():
+		return nil, &domain.NotReadyError{Resource: statIndexResource, RetryAfter: statIndexRetryAfter}
+	}
+}
+
+// start runs build in the background. The caller must hold i.mu.
+func (i *statIndex) start(build func(ctx context.Context) (*statDistribution, error)) *statBuild {
+	current := &statBuild{done: make(chan struct{})}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), statIndexTimeout)
+		defer cancel()
+
+		current.distribution, current.err = build(ctx)
+
+		i.mu.Lock()
+		if current.err == nil {
+			i.distribution = current.distribution
+			i.expires = time.Now().Add(statIndexTTL)
+		} else {
+			i.retryAt = time.Now().Add(statIndexBackoff)
+		}
+		i.building = nil
+		i.mu.Unlock()
+
+		close(current.done)
+	}()
+
+	return current
+}
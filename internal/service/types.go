@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// allTypesLimit is large enough to list every type in a single request
+const allTypesLimit = 100
+
+// nonBattleTypes are listed by the PokeAPI but no move deals damage of that type
+var nonBattleTypes = map[string]bool{
+	"unknown": true,
+	"shadow":  true,
+	"stellar": true,
+}
+
+// ListTypes retrieves a page of types
+func (s *PokemonService) ListTypes(ctx context.Context, page, limit int) (*domain.ResourceList, error) {
+	return s.listResources(ctx, "type", page, limit)
+}
+
+// GetType retrieves a type and its damage relations, with its name in the most preferred of languages
+func (s *PokemonService) GetType(ctx context.Context, name string, languages []string) (*domain.TypeDetails, error) {
+	// Validate input
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("%w: type name cannot be empty", domain.ErrInvalidInput)
+	}
+
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	s.logger.Info("Getting type",
+		zap.String("name", name),
+	)
+
+	details, err := s.client.FetchType(ctx, name)
+	if err != nil {
+		s.logger.Error("Failed to get type",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	details.LocalizedName = domain.LocalizeName(details.Names, normalizeLanguages(languages))
+
+	return details, nil
+}
+
+// GetMatchups retrieves the defensive type matchups of a Pokemon.
+// The multiplier for each attacking type is the product of what every one
+// of the Pokemon's types takes from it, so dual types yield 0.25 and 4.
+func (s *PokemonService) GetMatchups(ctx context.Context, nameOrID string) (*domain.TypeMatchups, error) {
+	pokemon, err := s.GetByName(ctx, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	defending := make([]*domain.TypeDetails, 0, len(pokemon.Types))
+	for _, pokemonType := range pokemon.Types {
+		details, err := s.GetType(ctx, pokemonType.Type.Name, nil)
+		if err != nil {
+			return nil, err
+		}
+		defending = append(defending, details)
+	}
+
+	attacking, err := s.battleTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return computeMatchups(pokemon.Name, defending, attacking), nil
+}
+
+// battleTypes returns the name of every type a move can deal damage with
+func (s *PokemonService) battleTypes(ctx context.Context) ([]string, error) {
+	types, _, err := s.client.FetchResourceList(ctx, "type", 0, allTypesLimit)
+	if err != nil {
+		s.logger.Error("Failed to list types", zap.Error(err))
+		return nil, err
+	}
+
+	names := make([]string, 0, len(types))
+	for _, t := range types {
+		if !nonBattleTypes[t.Name] {
+			names = append(names, t.Name)
+		}
+	}
+
+	return names, nil
+}
+
+// computeMatchups builds the defensive multiplier table for a set of defending types
+func computeMatchups(pokemon string, defending []*domain.TypeDetails, attacking []string) *domain.TypeMatchups {
+	matchups := &domain.TypeMatchups{
+		Pokemon:     pokemon,
+		Types:       make([]string, 0, len(defending)),
+		Multipliers: make(map[string]float64, len(attacking)),
+		Immune:      []string{},
+		Quarter:     []string{},
+		Half:        []string{},
+		Neutral:     []string{},
+		Double:      []string{},
+		Quadruple:   []string{},
+	}
+
+	for _, t := range defending {
+		matchups.Types = append(matchups.Types, t.Name)
+	}
+
+	for _, attack := range attacking {
+		multiplier := 1.0
+		for _, t := range defending {
+			multiplier *= t.DamageFrom(attack)
+		}
+		matchups.Multipliers[attack] = multiplier
+
+		switch multiplier {
+		case 0:
+			matchups.Immune = append(matchups.Immune, attack)
+		case 0.25:
+			matchups.Quarter = append(matchups.Quarter, attack)
+		case 0.5:
+			matchups.Half = append(matchups.Half, attack)
+		case 2:
+			matchups.Double = append(matchups.Double, attack)
+		case 4:
+			matchups.Quadruple = append(matchups.Quadruple, attack)
+		default:
+			matchups.Neutral = append(matchups.Neutral, attack)
+		}
+	}
+
+	return matchups
+}
@@ -0,0 +1,108 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// abilityFixtures serves Pikachu and its abilities
+var abilityFixtures = map[string]string{
+	"/pokemon/pikachu":       "pokemon_response.json",
+	"/ability/static":        "ability_static_response.json",
+	"/ability/lightning-rod": "ability_lightning_rod_response.json",
+}
+
+func TestGetAbility(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, abilityFixtures))
+
+	tests := []struct {
+		name             string
+		path             string
+		expectedStatus   int
+		expectedLanguage string
+	}{
+		{
+			name:             "Default language",
+			path:             "/api/v1/abilities/static",
+			expectedStatus:   http.StatusOK,
+			expectedLanguage: "en",
+		},
+		{
+			name:             "Translated",
+			path:             "/api/v1/abilities/static?lang=de",
+			expectedStatus:   http.StatusOK,
+			expectedLanguage: "de",
+		},
+		{
+			name:             "Falls back to English",
+			path:             "/api/v1/abilities/static?lang=fr",
+			expectedStatus:   http.StatusOK,
+			expectedLanguage: "en",
+		},
+		{
+			name:           "Unknown ability",
+			path:           "/api/v1/abilities/telepathy-plus",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expectedStatus, w.Code)
+			if w.Code != http.StatusOK {
+				return
+			}
+
+			var ability domain.AbilitySummary
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&ability))
+			assert.Equal(t, "static", ability.Name)
+			assert.Equal(t, "generation-iii", ability.Generation)
+			assert.Equal(t, tt.expectedLanguage, ability.Effect.Language)
+			assert.NotEmpty(t, ability.Effect.ShortEffect)
+			require.Len(t, ability.Pokemon, 3)
+			assert.True(t, ability.Pokemon[2].IsHidden)
+		})
+	}
+}
+
+func TestGetPokemonExpandAbilities(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, abilityFixtures))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu?expand=abilities", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var pokemon domain.Pokemon
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&pokemon))
+	require.Len(t, pokemon.Abilities, 2)
+	for _, ability := range pokemon.Abilities {
+		require.NotNil(t, ability.Effect, ability.Ability.Name)
+		assert.NotEmpty(t, ability.Effect.Effect)
+	}
+
+	// Abilities are only expanded on request
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "short_effect")
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu?expand=moves", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,358 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/polgarcia/golang-rest-api/internal/cache"
+	"github.com/polgarcia/golang-rest-api/internal/client"
+	"github.com/polgarcia/golang-rest-api/internal/config"
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"github.com/polgarcia/golang-rest-api/internal/handler"
+	"github.com/polgarcia/golang-rest-api/internal/server"
+	"github.com/polgarcia/golang-rest-api/internal/service"
+	"github.com/polgarcia/golang-rest-api/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCountingUpstream serves the Pikachu fixture for /pokemon/pikachu and /pokemon/25,
+// 404 for everything else, and counts the requests it receives
+func newCountingUpstream(t *testing.T) (*httptest.Server, *int64) {
+	fixture, err := os.ReadFile("../testdata/pokemon_response.json")
+	require.NoError(t, err)
+
+	var hits int64
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&hits, 1)
+
+		switch r.URL.Path {
+		case "/pokemon/pikachu", "/pokemon/25":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(fixture)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(upstream.Close)
+
+	return upstream, &hits
+}
+
+func TestCachedClient(t *testing.T) {
+	log, err := logger.New("error", "console")
+	require.NoError(t, err)
+
+	upstream, hits := newCountingUpstream(t)
+	ctx := context.Background()
+
+	cached, err := client.NewCachedClient(
+		client.NewPokeAPIClient(upstream.URL, 5*time.Second, log),
+		config.CacheConfig{
+			Enabled:     true,
+			Backend:     "memory",
+			TTL:         time.Minute,
+			NegativeTTL: time.Minute,
+			MaxEntries:  10,
+		},
+		log,
+	)
+	require.NoError(t, err)
+
+	t.Run("Name and ID share an entry", func(t *testing.T) {
+		pokemon, err := cached.FetchPokemon(ctx, "Pikachu")
+		require.NoError(t, err)
+		assert.Equal(t, 25, pokemon.ID)
+
+		pokemon, err = cached.FetchPokemon(ctx, "25")
+		require.NoError(t, err)
+		assert.Equal(t, "pikachu", pokemon.Name)
+
+		assert.Equal(t, int64(1), atomic.LoadInt64(hits))
+	})
+
+	t.Run("Not found is cached", func(t *testing.T) {
+		atomic.StoreInt64(hits, 0)
+
+		for i := 0; i < 3; i++ {
+			_, err := cached.FetchPokemon(ctx, "missingno")
+			assert.ErrorIs(t, err, domain.ErrPokemonNotFound)
+		}
+
+		assert.Equal(t, int64(1), atomic.LoadInt64(hits))
+	})
+
+	t.Run("Cached values are copies", func(t *testing.T) {
+		pokemon, err := cached.FetchPokemon(ctx, "pikachu")
+		require.NoError(t, err)
+		pokemon.Types = nil
+
+		pokemon, err = cached.FetchPokemon(ctx, "pikachu")
+		require.NoError(t, err)
+		assert.NotEmpty(t, pokemon.Types)
+	})
+}
+
+func TestCachedClientEviction(t *testing.T) {
+	log, err := logger.New("error", "console")
+	require.NoError(t, err)
+
+	upstream, hits := newCountingUpstream(t)
+	ctx := context.Background()
+
+	cached, err := client.NewCachedClient(
+		client.NewPokeAPIClient(upstream.URL, 5*time.Second, log),
+		config.CacheConfig{
+			Enabled:     true,
+			Backend:     "memory",
+			TTL:         time.Minute,
+			NegativeTTL: time.Minute,
+			MaxEntries:  2,
+		},
+		log,
+	)
+	require.NoError(t, err)
+
+	// Pikachu occupies both entries (the ID and an alias for its name); a miss evicts one of them
+	_, err = cached.FetchPokemon(ctx, "pikachu")
+	require.NoError(t, err)
+	_, err = cached.FetchPokemon(ctx, "missingno")
+	require.ErrorIs(t, err, domain.ErrPokemonNotFound)
+	_, err = cached.FetchPokemon(ctx, "missingno")
+	require.ErrorIs(t, err, domain.ErrPokemonNotFound)
+
+	// The alias was evicted, the entry it pointed to is still cached
+	_, err = cached.FetchPokemon(ctx, "25")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), atomic.LoadInt64(hits))
+
+	_, err = cached.FetchPokemon(ctx, "pikachu")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), atomic.LoadInt64(hits))
+}
+
+func TestCachedClientFileBackendSurvivesRestart(t *testing.T) {
+	log, err := logger.New("error", "console")
+	require.NoError(t, err)
+
+	upstream, hits := newCountingUpstream(t)
+	ctx := context.Background()
+
+	cfg := config.CacheConfig{
+		Enabled:     true,
+		Backend:     "file",
+		Dir:         t.TempDir(),
+		TTL:         time.Minute,
+		NegativeTTL: time.Minute,
+	}
+
+	first, err := client.NewCachedClient(client.NewPokeAPIClient(upstream.URL, 5*time.Second, log), cfg, log)
+	require.NoError(t, err)
+
+	_, err = first.FetchPokemon(ctx, "pikachu")
+	require.NoError(t, err)
+
+	// A new client over the same directory simulates a restarted instance
+	second, err := client.NewCachedClient(client.NewPokeAPIClient(upstream.URL, 5*time.Second, log), cfg, log)
+	require.NoError(t, err)
+
+	pokemon, err := second.FetchPokemon(ctx, "25")
+	require.NoError(t, err)
+	assert.Equal(t, "pikachu", pokemon.Name)
+	assert.Equal(t, int64(1), atomic.LoadInt64(hits))
+}
+
+func TestCachedClientFileBackendEviction(t *testing.T) {
+	log, err := logger.New("error", "console")
+	require.NoError(t, err)
+
+	upstream, hits := newCountingUpstream(t)
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	cfg := config.CacheConfig{
+		Enabled:     true,
+		Backend:     "file",
+		Dir:         dir,
+		TTL:         time.Minute,
+		NegativeTTL: time.Minute,
+		MaxEntries:  2,
+	}
+
+	cached, err := client.NewCachedClient(client.NewPokeAPIClient(upstream.URL, 5*time.Second, log), cfg, log)
+	require.NoError(t, err)
+
+	// Pikachu occupies both files (ID and name); a miss evicts one of them
+	_, err = cached.FetchPokemon(ctx, "pikachu")
+	require.NoError(t, err)
+	_, err = cached.FetchPokemon(ctx, "missingno")
+	require.ErrorIs(t, err, domain.ErrPokemonNotFound)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 2)
+
+	_, err = cached.FetchPokemon(ctx, "pikachu")
+	require.NoError(t, err)
+	_, err = cached.FetchPokemon(ctx, "25")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), atomic.LoadInt64(hits))
+}
+
+func TestCachedClientServesStale(t *testing.T) {
+	log, err := logger.New("error", "console")
+	require.NoError(t, err)
+
+	fixture, err := os.ReadFile("../testdata/pokemon_response.json")
+	require.NoError(t, err)
+
+	var failing atomic.Bool
+	var hits int64
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Only the Pokemon is served; its species, for the alternate forms, is left out
+		if r.URL.Path != "/pokemon/pikachu" {
+			http.NotFound(w, r)
+			return
+		}
+
+		atomic.AddInt64(&hits, 1)
+		if failing.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write(fixture)
+	}))
+	t.Cleanup(upstream.Close)
+
+	newRouter := func(cfg config.CacheConfig) http.Handler {
+		pokemonClient := client.NewPokeAPIClient(upstream.URL, 5*time.Second, log,
+			client.WithRetryPolicy(config.RetryConfig{MaxAttempts: 1, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}),
+		)
+		cached, err := client.NewCachedClient(pokemonClient, cfg, log)
+		require.NoError(t, err)
+
+		h := handler.NewHandler(service.NewPokemonService(cached, log), log)
+		return server.SetupRoutes(h, log, "*")
+	}
+
+	get := func(router http.Handler) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("Stale while revalidate", func(t *testing.T) {
+		failing.Store(false)
+		atomic.StoreInt64(&hits, 0)
+
+		router := newRouter(config.CacheConfig{
+			Enabled:              true,
+			Backend:              "memory",
+			TTL:                  50 * time.Millisecond,
+			StaleWhileRevalidate: time.Hour,
+			MaxEntries:           10,
+		})
+
+		w := get(router)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "MISS", w.Header().Get("X-Cache"))
+
+		w = get(router)
+		assert.Equal(t, "HIT", w.Header().Get("X-Cache"))
+
+		time.Sleep(60 * time.Millisecond)
+
+		w = get(router)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "STALE", w.Header().Get("X-Cache"))
+		assert.Equal(t, `110 - "Response is Stale"`, w.Header().Get("Warning"))
+
+		// The background refresh makes the entry fresh again
+		require.Eventually(t, func() bool { return atomic.LoadInt64(&hits) == 2 }, time.Second, 5*time.Millisecond)
+		require.Eventually(t, func() bool { return get(router).Header().Get("X-Cache") == "HIT" }, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("Stale if error", func(t *testing.T) {
+		failing.Store(false)
+
+		router := newRouter(config.CacheConfig{
+			Enabled:      true,
+			Backend:      "memory",
+			TTL:          50 * time.Millisecond,
+			StaleIfError: time.Hour,
+			MaxEntries:   10,
+		})
+
+		w := get(router)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		time.Sleep(60 * time.Millisecond)
+		failing.Store(true)
+
+		w = get(router)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "STALE", w.Header().Get("X-Cache"))
+		assert.Equal(t, `111 - "Revalidation Failed"`, w.Header().Get("Warning"))
+
+		var pokemon domain.Pokemon
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&pokemon))
+		assert.Equal(t, "pikachu", pokemon.Name)
+	})
+
+	t.Run("Stale if error window", func(t *testing.T) {
+		failing.Store(false)
+
+		// Entries are kept for the longer stale-while-revalidate window,
+		// but only served on errors within the shorter stale-if-error one
+		router := newRouter(config.CacheConfig{
+			Enabled:              true,
+			Backend:              "memory",
+			TTL:                  50 * time.Millisecond,
+			StaleWhileRevalidate: 100 * time.Millisecond,
+			StaleIfError:         10 * time.Millisecond,
+			MaxEntries:           10,
+		})
+
+		w := get(router)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		time.Sleep(170 * time.Millisecond)
+		failing.Store(true)
+
+		w = get(router)
+		assert.Equal(t, http.StatusBadGateway, w.Code)
+		assert.Empty(t, w.Header().Get("Warning"))
+	})
+}
+
+func TestMemoryStoreByteBound(t *testing.T) {
+	store := cache.NewMemoryBytes(10)
+
+	store.Set("a", []byte("aaaa"), time.Minute)
+	store.Set("b", []byte("bbbb"), time.Minute)
+
+	// Storing c goes over 10 bytes, so the least recently used value is evicted
+	_, ok := store.Get("a")
+	require.True(t, ok)
+	store.Set("c", []byte("cccc"), time.Minute)
+
+	_, ok = store.Get("b")
+	assert.False(t, ok)
+	_, ok = store.Get("a")
+	assert.True(t, ok)
+	_, ok = store.Get("c")
+	assert.True(t, ok)
+
+	// A value larger than the bound is not stored at all
+	store.Set("d", []byte("ddddddddddd"), time.Minute)
+	_, ok = store.Get("d")
+	assert.False(t, ok)
+	assert.Equal(t, 2, store.Len())
+}
@@ -0,0 +1,522 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/polgarcia/golang-rest-api/internal/cache"
+	"github.com/polgarcia/golang-rest-api/internal/client"
+	"github.com/polgarcia/golang-rest-api/internal/config"
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"github.com/polgarcia/golang-rest-api/internal/handler"
+	"github.com/polgarcia/golang-rest-api/internal/server"
+	"github.com/polgarcia/golang-rest-api/internal/service"
+	"github.com/polgarcia/golang-rest-api/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSlowUpstream serves the Pikachu fixture after waiting for release to be closed
+func newSlowUpstream(t *testing.T, release <-chan struct{}) (*httptest.Server, *int64) {
+	fixture, err := os.ReadFile("../testdata/pokemon_response.json")
+	require.NoError(t, err)
+
+	var hits int64
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&hits, 1)
+		<-release
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(fixture)
+	}))
+	t.Cleanup(upstream.Close)
+
+	return upstream, &hits
+}
+
+func TestClientCoalescesConcurrentRequests(t *testing.T) {
+	log, err := logger.New("error", "console")
+	require.NoError(t, err)
+
+	release := make(chan struct{})
+	upstream, hits := newSlowUpstream(t, release)
+	pokemonClient := client.NewPokeAPIClient(upstream.URL, 5*time.Second, log)
+
+	const callers = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pokemon, err := pokemonClient.FetchPokemon(context.Background(), "pikachu")
+			if err == nil && pokemon.ID != 25 {
+				t.Errorf("unexpected Pokemon ID %d", pokemon.ID)
+			}
+			errs <- err
+		}()
+	}
+
+	// A caller that gives up must not fail the others
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancelledErr := make(chan error, 1)
+	go func() {
+		_, err := pokemonClient.FetchPokemon(cancelled, "pikachu")
+		cancelledErr <- err
+	}()
+
+	require.Eventually(t, func() bool { return atomic.LoadInt64(hits) == 1 }, time.Second, 5*time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	assert.Error(t, <-cancelledErr)
+
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int64(1), atomic.LoadInt64(hits))
+}
+
+func TestClientCoalescedRequestOutlivesFirstDeadline(t *testing.T) {
+	log, err := logger.New("error", "console")
+	require.NoError(t, err)
+
+	release := make(chan struct{})
+	upstream, hits := newSlowUpstream(t, release)
+	pokemonClient := client.NewPokeAPIClient(upstream.URL, 5*time.Second, log)
+
+	// The first caller has a short deadline
+	short, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	shortErr := make(chan error, 1)
+	go func() {
+		_, err := pokemonClient.FetchPokemon(short, "pikachu")
+		shortErr <- err
+	}()
+	require.Eventually(t, func() bool { return atomic.LoadInt64(hits) == 1 }, time.Second, 5*time.Millisecond)
+
+	// A caller without a deadline joins the same call
+	longErr := make(chan error, 1)
+	go func() {
+		pokemon, err := pokemonClient.FetchPokemon(context.Background(), "pikachu")
+		if err == nil && pokemon.ID != 25 {
+			t.Errorf("unexpected Pokemon ID %d", pokemon.ID)
+		}
+		longErr <- err
+	}()
+
+	assert.Error(t, <-shortErr)
+	close(release)
+	assert.NoError(t, <-longErr)
+	assert.Equal(t, int64(1), atomic.LoadInt64(hits))
+}
+
+func TestClientCircuitBreaker(t *testing.T) {
+	log, err := logger.New("error", "console")
+	require.NoError(t, err)
+
+	var hits int64
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&hits, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(upstream.Close)
+
+	pokemonClient := client.NewPokeAPIClient(upstream.URL, 5*time.Second, log,
+		client.WithCircuitBreaker(config.BreakerConfig{
+			FailureThreshold: 1,
+			Cooldown:         time.Minute,
+			HalfOpenRequests: 1,
+		}),
+	)
+	h := handler.NewHandler(service.NewPokemonService(pokemonClient, log), log,
+		handler.WithUpstreamMonitor(pokemonClient),
+	)
+	router := server.SetupRoutes(h, log, "*")
+
+	// The first failure opens the circuit and stops further retries
+	_, err = pokemonClient.FetchPokemon(context.Background(), "pikachu")
+	assert.ErrorIs(t, err, domain.ErrExternalAPI)
+	assert.Equal(t, int64(1), atomic.LoadInt64(&hits))
+	assert.Equal(t, domain.CircuitOpen, pokemonClient.CircuitState())
+
+	// While open, requests fail fast without reaching the upstream
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadGateway, w.Code)
+	assert.Equal(t, int64(1), atomic.LoadInt64(&hits))
+
+	// The open circuit is reported by the health endpoint
+	req = httptest.NewRequest(http.MethodGet, "/health", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	var health handler.HealthResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&health))
+	assert.Equal(t, "degraded", health.Status)
+	require.NotNil(t, health.Upstream)
+	assert.Equal(t, domain.CircuitOpen, health.Upstream.CircuitBreaker)
+}
+
+func TestClientAssetRequests(t *testing.T) {
+	log, err := logger.New("error", "console")
+	require.NoError(t, err)
+
+	fixture, err := os.ReadFile("../testdata/pokemon_response.json")
+	require.NoError(t, err)
+
+	var assetHits int64
+	var accept atomic.Value
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pokemon/pikachu":
+			_, _ = w.Write(fixture)
+		case "/sprites/25.png":
+			atomic.AddInt64(&assetHits, 1)
+			accept.Store(r.Header.Get("Accept"))
+			_, _ = w.Write([]byte("png"))
+		case "/sprites/huge.png":
+			atomic.AddInt64(&assetHits, 1)
+			_, _ = w.Write(make([]byte, 9<<20))
+		default:
+			atomic.AddInt64(&assetHits, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(upstream.Close)
+
+	pokemonClient := client.NewPokeAPIClient(upstream.URL, 5*time.Second, log,
+		client.WithCircuitBreaker(config.BreakerConfig{
+			FailureThreshold: 1,
+			Cooldown:         time.Minute,
+			HalfOpenRequests: 1,
+		}),
+	)
+	ctx := context.Background()
+
+	t.Run("Accept header", func(t *testing.T) {
+		data, err := pokemonClient.FetchAsset(ctx, upstream.URL+"/sprites/25.png")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("png"), data)
+		assert.NotContains(t, accept.Load(), "application/json")
+	})
+
+	t.Run("Size cap", func(t *testing.T) {
+		atomic.StoreInt64(&assetHits, 0)
+
+		// An oversized asset is neither retried nor counted against the breaker
+		_, err := pokemonClient.FetchAsset(ctx, upstream.URL+"/sprites/huge.png")
+		assert.ErrorIs(t, err, domain.ErrExternalAPI)
+		assert.Equal(t, int64(1), atomic.LoadInt64(&assetHits))
+
+		_, err = pokemonClient.FetchAsset(ctx, upstream.URL+"/sprites/25.png")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Separate circuit breaker", func(t *testing.T) {
+		_, err := pokemonClient.FetchAsset(ctx, upstream.URL+"/sprites/broken.png")
+		assert.ErrorIs(t, err, domain.ErrExternalAPI)
+
+		// The asset circuit is open, the PokeAPI one is not
+		atomic.StoreInt64(&assetHits, 0)
+		_, err = pokemonClient.FetchAsset(ctx, upstream.URL+"/sprites/25.png")
+		assert.ErrorIs(t, err, domain.ErrExternalAPI)
+		assert.Zero(t, atomic.LoadInt64(&assetHits))
+
+		assert.Equal(t, domain.CircuitClosed, pokemonClient.CircuitState())
+		_, err = pokemonClient.FetchPokemon(ctx, "pikachu")
+		assert.NoError(t, err)
+	})
+}
+
+func TestClientRetryPolicy(t *testing.T) {
+	log, err := logger.New("error", "console")
+	require.NoError(t, err)
+
+	fixture, err := os.ReadFile("../testdata/pokemon_response.json")
+	require.NoError(t, err)
+
+	retryPolicy := client.WithRetryPolicy(config.RetryConfig{
+		MaxAttempts: 3,
+		BaseDelay:   10 * time.Millisecond,
+		MaxDelay:    50 * time.Millisecond,
+	})
+
+	t.Run("Retries 503 honoring Retry-After", func(t *testing.T) {
+		var hits int64
+		upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt64(&hits, 1) == 1 {
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write(fixture)
+		}))
+		t.Cleanup(upstream.Close)
+
+		pokemonClient := client.NewPokeAPIClient(upstream.URL, 5*time.Second, log,
+			client.WithRetryPolicy(config.RetryConfig{
+				MaxAttempts: 3,
+				BaseDelay:   10 * time.Millisecond,
+				MaxDelay:    2 * time.Second,
+			}),
+		)
+
+		start := time.Now()
+		pokemon, err := pokemonClient.FetchPokemon(context.Background(), "pikachu")
+		require.NoError(t, err)
+		assert.Equal(t, 25, pokemon.ID)
+		assert.Equal(t, int64(2), atomic.LoadInt64(&hits))
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	})
+
+	t.Run("Does not retry client errors", func(t *testing.T) {
+		var hits int64
+		upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt64(&hits, 1)
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		t.Cleanup(upstream.Close)
+
+		pokemonClient := client.NewPokeAPIClient(upstream.URL, 5*time.Second, log, retryPolicy)
+
+		_, err := pokemonClient.FetchPokemon(context.Background(), "pikachu")
+		assert.ErrorIs(t, err, domain.ErrExternalAPI)
+		assert.Equal(t, int64(1), atomic.LoadInt64(&hits))
+	})
+
+	t.Run("Gives up when Retry-After exceeds the maximum delay", func(t *testing.T) {
+		var hits int64
+		upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt64(&hits, 1)
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		t.Cleanup(upstream.Close)
+
+		pokemonClient := client.NewPokeAPIClient(upstream.URL, 5*time.Second, log, retryPolicy)
+
+		start := time.Now()
+		_, err := pokemonClient.FetchPokemon(context.Background(), "pikachu")
+		assert.ErrorIs(t, err, domain.ErrExternalAPI)
+		assert.Equal(t, int64(1), atomic.LoadInt64(&hits))
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("Stops at the client timeout without a deadline", func(t *testing.T) {
+		var hits int64
+		upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt64(&hits, 1)
+			w.Header().Set("Retry-After", "2")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		t.Cleanup(upstream.Close)
+
+		pokemonClient := client.NewPokeAPIClient(upstream.URL, time.Second, log,
+			client.WithRetryPolicy(config.RetryConfig{
+				MaxAttempts: 3,
+				BaseDelay:   10 * time.Millisecond,
+				MaxDelay:    5 * time.Second,
+			}),
+		)
+
+		start := time.Now()
+		_, err := pokemonClient.FetchPokemon(context.Background(), "pikachu")
+		assert.ErrorIs(t, err, domain.ErrExternalAPI)
+		assert.Equal(t, int64(1), atomic.LoadInt64(&hits))
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("Stops when the wait exceeds the deadline", func(t *testing.T) {
+		var hits int64
+		upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt64(&hits, 1)
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		t.Cleanup(upstream.Close)
+
+		pokemonClient := client.NewPokeAPIClient(upstream.URL, 5*time.Second, log, retryPolicy)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		start := time.Now()
+		_, err := pokemonClient.FetchPokemon(ctx, "pikachu")
+		assert.ErrorIs(t, err, domain.ErrExternalAPI)
+		assert.Equal(t, int64(1), atomic.LoadInt64(&hits))
+		assert.Less(t, time.Since(start), time.Second)
+	})
+}
+
+func TestClientRateLimit(t *testing.T) {
+	log, err := logger.New("error", "console")
+	require.NoError(t, err)
+
+	fixture, err := os.ReadFile("../testdata/pokemon_response.json")
+	require.NoError(t, err)
+
+	var hits int64
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&hits, 1)
+		_, _ = w.Write(fixture)
+	}))
+	t.Cleanup(upstream.Close)
+
+	pokemonClient := client.NewPokeAPIClient(upstream.URL, 5*time.Second, log,
+		client.WithRateLimit(config.RateLimitConfig{RequestsPerSecond: 0.5, Burst: 1}),
+	)
+	router := server.SetupRoutes(handler.NewHandler(service.NewPokemonService(pokemonClient, log), log), log, "*")
+
+	request := func() *httptest.ResponseRecorder {
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		defer cancel()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu", nil).WithContext(ctx)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// The burst token is used by the first request
+	w := request()
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// The next token is two seconds away, beyond the request deadline
+	w = request()
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "2", w.Header().Get("Retry-After"))
+	assert.Equal(t, int64(1), atomic.LoadInt64(&hits))
+}
+
+func TestClientRateLimitMaxWait(t *testing.T) {
+	log, err := logger.New("error", "console")
+	require.NoError(t, err)
+
+	fixture, err := os.ReadFile("../testdata/pokemon_response.json")
+	require.NoError(t, err)
+
+	var hits int64
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&hits, 1)
+		_, _ = w.Write(fixture)
+	}))
+	t.Cleanup(upstream.Close)
+
+	pokemonClient := client.NewPokeAPIClient(upstream.URL, 5*time.Second, log,
+		client.WithRateLimit(config.RateLimitConfig{RequestsPerSecond: 0.5, Burst: 1, MaxWait: 500 * time.Millisecond}),
+	)
+	router := server.SetupRoutes(handler.NewHandler(service.NewPokemonService(pokemonClient, log), log), log, "*")
+
+	// Plain requests have no deadline, so the maximum wait applies
+	request := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := request()
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	start := time.Now()
+	w = request()
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "2", w.Header().Get("Retry-After"))
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, int64(1), atomic.LoadInt64(&hits))
+}
+
+func TestClientBackgroundRequests(t *testing.T) {
+	log, err := logger.New("error", "console")
+	require.NoError(t, err)
+
+	fixture, err := os.ReadFile("../testdata/pokemon_response.json")
+	require.NoError(t, err)
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/pokemon/pikachu" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write(fixture)
+	}))
+	t.Cleanup(upstream.Close)
+
+	pokemonClient := client.NewPokeAPIClient(upstream.URL, 5*time.Second, log,
+		client.WithCircuitBreaker(config.BreakerConfig{
+			FailureThreshold: 1,
+			Cooldown:         time.Minute,
+			HalfOpenRequests: 1,
+		}),
+		client.WithRateLimit(config.RateLimitConfig{RequestsPerSecond: 0.5, Burst: 1}),
+	)
+	background := domain.AsBackground(context.Background())
+
+	// A background failure opens the background circuit only
+	_, err = pokemonClient.FetchPokemon(background, "broken")
+	assert.ErrorIs(t, err, domain.ErrExternalAPI)
+	assert.Equal(t, domain.CircuitClosed, pokemonClient.CircuitState())
+
+	// The background request did not take the user token
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	_, err = pokemonClient.FetchPokemon(ctx, "pikachu")
+	require.NoError(t, err)
+
+	// Background requests wait on their own, slower bucket
+	ctx, cancel = context.WithTimeout(background, 500*time.Millisecond)
+	defer cancel()
+	_, err = pokemonClient.FetchPokemon(ctx, "pikachu")
+	var rateLimitErr *domain.RateLimitError
+	require.ErrorAs(t, err, &rateLimitErr)
+	assert.Greater(t, rateLimitErr.RetryAfter, 2*time.Second)
+}
+
+func TestClientRevalidation(t *testing.T) {
+	log, err := logger.New("error", "console")
+	require.NoError(t, err)
+
+	fixture, err := os.ReadFile("../testdata/pokemon_response.json")
+	require.NoError(t, err)
+
+	var fullResponses, notModified int64
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"pikachu-v1"` {
+			atomic.AddInt64(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		atomic.AddInt64(&fullResponses, 1)
+		w.Header().Set("ETag", `"pikachu-v1"`)
+		_, _ = w.Write(fixture)
+	}))
+	t.Cleanup(upstream.Close)
+
+	pokemonClient := client.NewPokeAPIClient(upstream.URL, 5*time.Second, log,
+		client.WithRevalidation(cache.NewMemory(10), time.Hour),
+	)
+
+	for i := 0; i < 3; i++ {
+		pokemon, err := pokemonClient.FetchPokemon(context.Background(), "pikachu")
+		require.NoError(t, err)
+		assert.Equal(t, 25, pokemon.ID)
+		assert.NotEmpty(t, pokemon.Stats)
+	}
+
+	assert.Equal(t, int64(1), atomic.LoadInt64(&fullResponses))
+	assert.Equal(t, int64(2), atomic.LoadInt64(&notModified))
+}
@@ -0,0 +1,138 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// compareFixtures serves Pikachu, also by ID, Gligar and Meowth with their types
+var compareFixtures = map[string]string{
+	"/pokemon/pikachu": "pokemon_response.json",
+	"/pokemon/25":      "pokemon_response.json",
+	"/pokemon/gligar":  "gligar_response.json",
+	"/pokemon/meowth":  "meowth_response.json",
+	"/type/electric":   "type_electric_response.json",
+	"/type/ground":     "type_ground_response.json",
+	"/type/flying":     "type_flying_response.json",
+	"/type/normal":     "type_normal_response.json",
+}
+
+// compare requests a comparison and decodes a successful response
+func compare(t *testing.T, router http.Handler, ids string) (*httptest.ResponseRecorder, *domain.PokemonComparison) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/compare?ids="+ids, nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		return w, nil
+	}
+
+	var comparison domain.PokemonComparison
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&comparison))
+	return w, &comparison
+}
+
+func TestComparePokemon(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, compareFixtures))
+
+	w, comparison := compare(t, router, "pikachu,Gligar,meowth")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{"pikachu", "gligar", "meowth"}, comparison.Pokemon)
+
+	t.Run("Stats", func(t *testing.T) {
+		stats := make(map[string]domain.StatComparison)
+		for _, stat := range comparison.Stats {
+			stats[stat.Name] = stat
+		}
+		require.Len(t, comparison.Stats, 7)
+		assert.Equal(t, "total", comparison.Stats[6].Name)
+
+		assert.Equal(t, []int{35, 65, 40}, stats["hp"].Values)
+		assert.Equal(t, []int{0, 30, 5}, stats["hp"].Deltas)
+		assert.Equal(t, []string{"gligar"}, stats["hp"].Highest)
+
+		// Gligar's fixture lists HP only, so its speed counts as 0
+		assert.Equal(t, []int{90, 0, 90}, stats["speed"].Values)
+		assert.Equal(t, []string{"pikachu", "meowth"}, stats["speed"].Highest)
+
+		assert.Equal(t, []int{320, 65, 290}, stats["total"].Values)
+		assert.Equal(t, []int{0, -255, -30}, stats["total"].Deltas)
+	})
+
+	t.Run("Types and abilities", func(t *testing.T) {
+		assert.Empty(t, comparison.Types.Shared)
+		assert.Equal(t, [][]string{{"electric"}, {"ground", "flying"}, {"normal"}}, comparison.Types.Unique)
+
+		assert.Empty(t, comparison.Abilities.Shared)
+		assert.Equal(t, [][]string{{"static", "lightning-rod"}, {"hyper-cutter"}, {}}, comparison.Abilities.Unique)
+	})
+
+	t.Run("Size", func(t *testing.T) {
+		assert.Equal(t, []int{4, 11, 4}, comparison.Size.Heights)
+		assert.Equal(t, []float64{1, 2.75, 1}, comparison.Size.HeightRatios)
+		assert.Equal(t, []int{60, 648, 42}, comparison.Size.Weights)
+		assert.Equal(t, []float64{1, 10.8, 0.7}, comparison.Size.WeightRatios)
+	})
+
+	t.Run("Head to head", func(t *testing.T) {
+		require.Len(t, comparison.HeadToHead, 3)
+
+		// Ground is immune to electric and hits it for double
+		pikachuGligar := comparison.HeadToHead[0]
+		assert.Equal(t, "pikachu", pikachuGligar.First)
+		assert.Equal(t, "gligar", pikachuGligar.Second)
+		assert.Equal(t, domain.TypeAttack{Type: "electric", Multiplier: 0}, pikachuGligar.FirstAttacking)
+		assert.Equal(t, domain.TypeAttack{Type: "ground", Multiplier: 2}, pikachuGligar.SecondAttacking)
+		assert.Equal(t, "gligar", pikachuGligar.Advantage)
+
+		pikachuMeowth := comparison.HeadToHead[1]
+		assert.Equal(t, 1.0, pikachuMeowth.FirstAttacking.Multiplier)
+		assert.Equal(t, 1.0, pikachuMeowth.SecondAttacking.Multiplier)
+		assert.Empty(t, pikachuMeowth.Advantage)
+
+		// Neither of Gligar's types beats the other against Meowth, so the first is reported
+		gligarMeowth := comparison.HeadToHead[2]
+		assert.Equal(t, domain.TypeAttack{Type: "ground", Multiplier: 1}, gligarMeowth.FirstAttacking)
+	})
+}
+
+func TestComparePokemonSameSpecies(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, compareFixtures))
+
+	w, comparison := compare(t, router, "pikachu,25")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	assert.Equal(t, []string{"pikachu", "pikachu"}, comparison.Pokemon)
+	assert.Equal(t, []string{"electric"}, comparison.Types.Shared)
+	assert.Equal(t, [][]string{{}, {}}, comparison.Types.Unique)
+	assert.Equal(t, []string{"pikachu"}, comparison.Stats[0].Highest)
+}
+
+func TestComparePokemonInvalid(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, compareFixtures))
+
+	tests := []struct {
+		name           string
+		ids            string
+		expectedStatus int
+	}{
+		{name: "Missing ids", ids: "", expectedStatus: http.StatusBadRequest},
+		{name: "Single Pokemon", ids: "pikachu", expectedStatus: http.StatusBadRequest},
+		{name: "Too many Pokemon", ids: "pikachu,25,gligar,meowth,pikachu,25,gligar", expectedStatus: http.StatusBadRequest},
+		{name: "Empty entry", ids: "pikachu,,gligar", expectedStatus: http.StatusBadRequest},
+		{name: "Unknown Pokemon", ids: "pikachu,missingno", expectedStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, _ := compare(t, router, tt.ids)
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package integration
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/polgarcia/golang-rest-api/internal/client"
+	"github.com/polgarcia/golang-rest-api/internal/config"
+	"github.com/polgarcia/golang-rest-api/internal/handler"
+	"github.com/polgarcia/golang-rest-api/internal/server"
+	"github.com/polgarcia/golang-rest-api/internal/service"
+	"github.com/polgarcia/golang-rest-api/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubCries serves Pikachu with its cry URLs pointing back at the stub, and the latest cry.
+// The legacy cry is missing. Downloads of the cry are counted.
+func stubCries(t *testing.T, cry []byte, downloads *int64) http.HandlerFunc {
+	fixture, err := os.ReadFile("../testdata/pokemon_response.json")
+	require.NoError(t, err)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pokemon/pikachu":
+			body := bytes.ReplaceAll(fixture,
+				[]byte("https://raw.githubusercontent.com/PokeAPI/cries/main"),
+				[]byte("http://"+r.Host))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(body)
+		case "/cries/pokemon/latest/25.ogg":
+			atomic.AddInt64(downloads, 1)
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = w.Write(cry)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+func TestGetPokemonCry(t *testing.T) {
+	cry, err := os.ReadFile("../testdata/cry_pikachu_latest.ogg")
+	require.NoError(t, err)
+
+	var downloads int64
+	router := setupStubServer(t, stubCries(t, cry, &downloads))
+
+	t.Run("Latest cry", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu/cry", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "audio/ogg", w.Header().Get("Content-Type"))
+		assert.Equal(t, "bytes", w.Header().Get("Accept-Ranges"))
+		assert.NotEmpty(t, w.Header().Get("ETag"))
+		assert.NotEmpty(t, w.Header().Get("Cache-Control"))
+		assert.Equal(t, cry, w.Body.Bytes())
+	})
+
+	t.Run("Range request", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu/cry?variant=latest", nil)
+		req.Header.Set("Range", "bytes=0-3")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusPartialContent, w.Code)
+		assert.Equal(t, "bytes 0-3/512", w.Header().Get("Content-Range"))
+		assert.Equal(t, []byte("OggS"), w.Body.Bytes())
+	})
+
+	t.Run("Conditional request", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu/cry", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		etag := w.Header().Get("ETag")
+
+		req = httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu/cry", nil)
+		req.Header.Set("If-None-Match", etag)
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Empty(t, w.Body.Bytes())
+	})
+
+	t.Run("Missing legacy cry", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu/cry?variant=legacy", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "Cry not found")
+	})
+
+	t.Run("Unknown variant", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu/cry?variant=remix", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetPokemonCryIsCached(t *testing.T) {
+	log, err := logger.New("error", "console")
+	require.NoError(t, err)
+
+	cry, err := os.ReadFile("../testdata/cry_pikachu_latest.ogg")
+	require.NoError(t, err)
+
+	var downloads int64
+	upstream := httptest.NewServer(stubCries(t, cry, &downloads))
+	t.Cleanup(upstream.Close)
+
+	cached, err := client.NewCachedClient(
+		client.NewPokeAPIClient(upstream.URL, 5*time.Second, log),
+		config.CacheConfig{
+			Enabled:    true,
+			Backend:    "memory",
+			TTL:        time.Minute,
+			MaxEntries: 10,
+		},
+		log,
+	)
+	require.NoError(t, err)
+
+	router := server.SetupRoutes(handler.NewHandler(service.NewPokemonService(cached, log), log), log, "*")
+
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu/cry", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, cry, w.Body.Bytes())
+	}
+
+	assert.Equal(t, int64(1), atomic.LoadInt64(&downloads))
+}
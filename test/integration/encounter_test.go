@@ -0,0 +1,100 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// encounterFixtures serves Pikachu's encounters and the location used by the encounter tests
+var encounterFixtures = map[string]string{
+	"/pokemon/pikachu":                    "pokemon_response.json",
+	"/pokemon/pikachu/encounters":         "pikachu_encounters_response.json",
+	"/location/viridian-forest":           "location_viridian_forest_response.json",
+	"/location-area/viridian-forest-area": "location_area_viridian_forest_response.json",
+}
+
+func TestGetPokemonEncounters(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, encounterFixtures))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu/encounters", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var encounters domain.PokemonEncounters
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&encounters))
+	assert.Equal(t, "pikachu", encounters.Pokemon)
+	require.Len(t, encounters.Versions, 2)
+	assert.Equal(t, "red", encounters.Versions[0].Version)
+	assert.Equal(t, "yellow", encounters.Versions[1].Version)
+
+	red := encounters.Versions[0]
+	require.Len(t, red.Areas, 2)
+	assert.Equal(t, "viridian-forest-area", red.Areas[0].LocationArea)
+	assert.Equal(t, "kanto-power-plant-area", red.Areas[1].LocationArea)
+	assert.Equal(t, 25, red.Areas[1].MaxChance)
+	require.Len(t, red.Areas[1].Encounters, 1)
+	assert.Equal(t, domain.Encounter{
+		Method:     "walk",
+		MinLevel:   20,
+		MaxLevel:   24,
+		Chance:     25,
+		Conditions: []string{"time-day"},
+	}, red.Areas[1].Encounters[0])
+
+	// Filtered to a single version
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu/encounters?version=Yellow", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	encounters = domain.PokemonEncounters{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&encounters))
+	require.Len(t, encounters.Versions, 1)
+	assert.Equal(t, "yellow", encounters.Versions[0].Version)
+	require.Len(t, encounters.Versions[0].Areas, 1)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/missingno/encounters", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGetLocation(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, encounterFixtures))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/locations/viridian-forest", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var location domain.LocationSummary
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&location))
+	assert.Equal(t, "kanto", location.Region)
+	assert.Equal(t, []string{"caterpie", "pikachu"}, location.Pokemon)
+	require.Len(t, location.Areas, 1)
+	require.Len(t, location.Areas[0].Pokemon, 2)
+	assert.Equal(t, domain.AreaPokemon{
+		Name:     "pikachu",
+		Versions: []string{"red", "yellow"},
+		MinLevel: 2,
+		MaxLevel: 5,
+	}, location.Areas[0].Pokemon[1])
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/locations/atlantis", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "Location not found")
+}
@@ -0,0 +1,65 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPokemonEvolution(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, map[string]string{
+		"/pokemon/pikachu":         "pokemon_response.json",
+		"/pokemon-species/pikachu": "species_response.json",
+		"/evolution-chain/10":      "evolution_chain_response.json",
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu/evolution", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var chain domain.EvolutionChain
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&chain))
+
+	assert.Equal(t, 10, chain.ID)
+	assert.Equal(t, "pichu", chain.Root.Species.Name)
+	assert.True(t, chain.Root.IsBaby)
+	assert.Empty(t, chain.Root.Conditions)
+
+	require.Len(t, chain.Root.EvolvesTo, 1)
+	pikachu := chain.Root.EvolvesTo[0]
+	assert.Equal(t, "pikachu", pikachu.Species.Name)
+	require.Len(t, pikachu.Conditions, 1)
+	assert.Equal(t, "level-up", pikachu.Conditions[0].Trigger)
+	require.NotNil(t, pikachu.Conditions[0].MinHappiness)
+	assert.Equal(t, 220, *pikachu.Conditions[0].MinHappiness)
+	assert.Nil(t, pikachu.Conditions[0].MinLevel)
+
+	require.Len(t, pikachu.EvolvesTo, 1)
+	raichu := pikachu.EvolvesTo[0]
+	assert.Equal(t, "raichu", raichu.Species.Name)
+	require.Len(t, raichu.Conditions, 1)
+	assert.Equal(t, "use-item", raichu.Conditions[0].Trigger)
+	assert.Equal(t, "thunder-stone", raichu.Conditions[0].Item)
+	assert.Empty(t, raichu.EvolvesTo)
+}
+
+func TestGetPokemonEvolutionChainNotFound(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, map[string]string{
+		"/pokemon/pikachu":         "pokemon_response.json",
+		"/pokemon-species/pikachu": "species_response.json",
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu/evolution", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "Evolution chain not found")
+}
@@ -0,0 +1,131 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// formFixtures serves Meowth's species, varieties and forms used by the form tests
+var formFixtures = map[string]string{
+	"/pokemon/meowth":            "meowth_response.json",
+	"/pokemon/meowth-alola":      "meowth_alola_response.json",
+	"/pokemon/meowth-galar":      "meowth_galar_response.json",
+	"/pokemon/meowth-gmax":       "meowth_gmax_response.json",
+	"/pokemon-species/meowth":    "species_meowth_response.json",
+	"/pokemon-form/meowth":       "form_meowth_response.json",
+	"/pokemon-form/meowth-alola": "form_meowth_alola_response.json",
+	"/pokemon-form/meowth-galar": "form_meowth_galar_response.json",
+	"/pokemon-form/meowth-gmax":  "form_meowth_gmax_response.json",
+}
+
+func TestGetPokemonForms(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, formFixtures))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/meowth-galar/forms", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var forms domain.PokemonForms
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&forms))
+	assert.Equal(t, "meowth", forms.Species)
+	require.Len(t, forms.Varieties, 4)
+
+	kinds := make(map[string]domain.FormKind)
+	for _, variety := range forms.Varieties {
+		kinds[variety.Name] = variety.Kind
+	}
+	assert.Equal(t, map[string]domain.FormKind{
+		"meowth":       domain.FormDefault,
+		"meowth-alola": domain.FormRegional,
+		"meowth-galar": domain.FormRegional,
+		"meowth-gmax":  domain.FormGigantamax,
+	}, kinds)
+
+	galar := forms.Varieties[2]
+	assert.False(t, galar.IsDefault)
+	assert.Equal(t, []string{"steel"}, galar.Types)
+	assert.Equal(t, 65, galar.Stats["attack"])
+	require.Len(t, galar.Forms, 1)
+	assert.Equal(t, "galar", galar.Forms[0].FormName)
+	assert.Equal(t, "sword-shield", galar.Forms[0].VersionGroup)
+
+	assert.True(t, forms.Varieties[3].Forms[0].IsBattleOnly)
+
+	// Unknown Pokemon
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/missingno/forms", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGetPokemonAlternateForms(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, formFixtures))
+
+	t.Run("Listed by default", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/meowth-galar", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var pokemon domain.Pokemon
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&pokemon))
+		require.Len(t, pokemon.AlternateForms, 3)
+		assert.Equal(t, domain.AlternateForm{
+			Name:  "meowth-alola",
+			URL:   "https://pokeapi.co/api/v2/pokemon/10107/",
+			Kind:  domain.FormRegional,
+			Types: []string{"dark"},
+			Stats: map[string]int{
+				"hp":              40,
+				"attack":          35,
+				"defense":         35,
+				"special-attack":  50,
+				"special-defense": 40,
+				"speed":           90,
+			},
+		}, pokemon.AlternateForms[1])
+
+		assert.Equal(t, "meowth", pokemon.AlternateForms[0].Name)
+		assert.Equal(t, domain.FormDefault, pokemon.AlternateForms[0].Kind)
+		assert.Equal(t, []string{"normal"}, pokemon.AlternateForms[0].Types)
+		assert.Equal(t, "meowth-gmax", pokemon.AlternateForms[2].Name)
+		assert.Equal(t, domain.FormGigantamax, pokemon.AlternateForms[2].Kind)
+	})
+
+	t.Run("Left out when a variety is unavailable", func(t *testing.T) {
+		router := setupStubServer(t, stubFixtures(t, map[string]string{
+			"/pokemon/meowth":         "meowth_response.json",
+			"/pokemon-species/meowth": "species_meowth_response.json",
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/meowth", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), "alternate_forms")
+	})
+
+	t.Run("Left out when the species is unavailable", func(t *testing.T) {
+		router := setupStubServer(t, stubFixtures(t, map[string]string{
+			"/pokemon/pikachu": "pokemon_response.json",
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), "alternate_forms")
+	})
+}
@@ -0,0 +1,170 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"github.com/polgarcia/golang-rest-api/internal/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// generationFixtures serves the generation, region and Pokedex resources used by the generation tests
+var generationFixtures = map[string]string{
+	"/generation":              "generation_list_response.json",
+	"/generation/generation-i": "generation_i_response.json",
+	"/region/kanto":            "region_kanto_response.json",
+	"/pokedex/kanto":           "pokedex_kanto_response.json",
+}
+
+func TestGenerations(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, generationFixtures))
+
+	t.Run("List generations", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/generations?limit=100", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var list handler.ListResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&list))
+		assert.Equal(t, 9, list.Total)
+		assert.Equal(t, "generation-i", list.Items[0].Name)
+	})
+
+	t.Run("Get generation", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/generations/Generation-I", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var generation domain.GenerationSummary
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&generation))
+		assert.Equal(t, "kanto", generation.MainRegion)
+		assert.Equal(t, []string{"red-blue", "yellow"}, generation.VersionGroups)
+		assert.Equal(t, []string{"normal", "electric"}, generation.Types)
+		assert.Equal(t, []string{"pound", "thunderbolt"}, generation.Moves)
+		assert.Empty(t, generation.Abilities)
+
+		// Species are ordered by National Pokedex number
+		names := make([]string, 0, len(generation.Species))
+		for _, species := range generation.Species {
+			names = append(names, species.Name)
+		}
+		assert.Equal(t, []string{"bulbasaur", "charmander", "pikachu", "raichu"}, names)
+	})
+
+	t.Run("Unknown generation", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/generations/generation-x", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "Generation not found")
+	})
+}
+
+func TestGetRegion(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, generationFixtures))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/regions/kanto", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var region domain.RegionSummary
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&region))
+	assert.Equal(t, "generation-i", region.MainGeneration)
+	assert.Equal(t, []string{"kanto", "letsgo-kanto"}, region.Pokedexes)
+	assert.Equal(t, []string{"celadon-city", "viridian-forest"}, region.Locations)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/regions/orre", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGetPokedex(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, generationFixtures))
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		checkResponse  func(t *testing.T, pokedex *handler.PokedexResponse)
+	}{
+		{
+			name:           "First page",
+			query:          "?limit=2",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, pokedex *handler.PokedexResponse) {
+				assert.Equal(t, "kanto", pokedex.Region)
+				assert.True(t, pokedex.IsMainSeries)
+				assert.Equal(t, 5, pokedex.Total)
+				assert.Equal(t, []domain.PokedexEntrySummary{
+					{EntryNumber: 1, Species: "bulbasaur"},
+					{EntryNumber: 2, Species: "ivysaur"},
+				}, pokedex.Entries)
+				require.NotNil(t, pokedex.Next)
+				assert.Equal(t, "/api/v1/pokedexes/kanto?limit=2&page=2", *pokedex.Next)
+				assert.Nil(t, pokedex.Prev)
+			},
+		},
+		{
+			name:           "Entries are ordered by entry number",
+			query:          "?page=2&limit=2",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, pokedex *handler.PokedexResponse) {
+				assert.Equal(t, []domain.PokedexEntrySummary{
+					{EntryNumber: 3, Species: "venusaur"},
+					{EntryNumber: 4, Species: "charmander"},
+				}, pokedex.Entries)
+			},
+		},
+		{
+			name:           "Past the last page",
+			query:          "?page=10&limit=2",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, pokedex *handler.PokedexResponse) {
+				assert.Empty(t, pokedex.Entries)
+				assert.Nil(t, pokedex.Next)
+			},
+		},
+		{
+			name:           "Page too large",
+			query:          "?page=9223372036854775807&limit=2",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid limit",
+			query:          "?limit=0",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/pokedexes/kanto"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.checkResponse != nil && w.Code == http.StatusOK {
+				var pokedex handler.PokedexResponse
+				err := json.NewDecoder(w.Body).Decode(&pokedex)
+				require.NoError(t, err)
+
+				tt.checkResponse(t, &pokedex)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// itemFixtures serves Pikachu and the items used by the item tests
+var itemFixtures = map[string]string{
+	"/pokemon/pikachu":  "pokemon_response.json",
+	"/item/light-ball":  "item_light_ball_response.json",
+	"/item/cheri-berry": "item_cheri_berry_response.json",
+	"/berry/cheri":      "berry_cheri_response.json",
+}
+
+func TestGetItem(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, itemFixtures))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/items/light-ball", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var item domain.ItemSummary
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&item))
+	assert.Equal(t, 1000, item.Cost)
+	assert.Equal(t, "species-specific", item.Category)
+	require.NotNil(t, item.FlingPower)
+	assert.Equal(t, 30, *item.FlingPower)
+	assert.Contains(t, item.Sprite, "light-ball.png")
+	assert.Equal(t, "Doubles Pikachu's Attack and Special Attack.", item.Effect.ShortEffect)
+	require.Len(t, item.HeldByPokemon, 1)
+	assert.Equal(t, "pikachu", item.HeldByPokemon[0].Pokemon.Name)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/items/master-key", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGetBerry(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, itemFixtures))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/berries/cheri", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var berry domain.BerrySummary
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&berry))
+	assert.Equal(t, "soft", berry.Firmness)
+	assert.Equal(t, map[string]int{"spicy": 10, "dry": 0}, berry.Flavors)
+	require.NotNil(t, berry.Item)
+	assert.Equal(t, "cheri-berry", berry.Item.Name)
+	assert.Equal(t, 80, berry.Item.Cost)
+}
+
+func TestGetPokemonHeldItems(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, itemFixtures))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var pokemon domain.Pokemon
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&pokemon))
+	require.Len(t, pokemon.HeldItems, 2)
+	assert.Equal(t, "light-ball", pokemon.HeldItems[1].Item.Name)
+	require.Len(t, pokemon.HeldItems[1].VersionDetails, 2)
+	assert.Equal(t, 5, pokemon.HeldItems[1].VersionDetails[0].Rarity)
+	assert.Equal(t, "ruby", pokemon.HeldItems[1].VersionDetails[0].Version.Name)
+}
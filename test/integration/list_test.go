@@ -0,0 +1,165 @@
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/polgarcia/golang-rest-api/internal/client"
+	"github.com/polgarcia/golang-rest-api/internal/handler"
+	"github.com/polgarcia/golang-rest-api/internal/server"
+	"github.com/polgarcia/golang-rest-api/internal/service"
+	"github.com/polgarcia/golang-rest-api/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupStubServer creates a test server backed by a stub upstream instead of PokeAPI
+func setupStubServer(t *testing.T, upstream http.Handler) http.Handler {
+	log, err := logger.New("error", "console")
+	require.NoError(t, err)
+
+	stub := httptest.NewServer(upstream)
+	t.Cleanup(stub.Close)
+
+	pokemonClient := client.NewPokeAPIClient(stub.URL, 30000000000, log)
+	pokemonService := service.NewPokemonService(pokemonClient, log)
+	h := handler.NewHandler(pokemonService, log, handler.WithUpstreamMonitor(pokemonClient))
+
+	return server.SetupRoutes(h, log, "*")
+}
+
+// stubPokemonList serves PokeAPI's /pokemon listing for a fixed number of Pokemon
+func stubPokemonList(total int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		results := []map[string]string{}
+		for i := offset; i < offset+limit && i < total; i++ {
+			results = append(results, map[string]string{
+				"name": fmt.Sprintf("pokemon-%d", i+1),
+				"url":  fmt.Sprintf("https://pokeapi.co/api/v2/pokemon/%d/", i+1),
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"count":   total,
+			"results": results,
+		})
+	}
+}
+
+func TestListPokemon(t *testing.T) {
+	router := setupStubServer(t, stubPokemonList(45))
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		checkResponse  func(t *testing.T, list *handler.ListResponse)
+	}{
+		{
+			name:           "First page with defaults",
+			query:          "",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, list *handler.ListResponse) {
+				assert.Len(t, list.Items, 20)
+				assert.Equal(t, 45, list.Total)
+				assert.Equal(t, 1, list.Page)
+				assert.Equal(t, 20, list.PageSize)
+				assert.Equal(t, "pokemon-1", list.Items[0].Name)
+				require.NotNil(t, list.Next)
+				assert.Equal(t, "/api/v1/pokemon?limit=20&page=2", *list.Next)
+				assert.Nil(t, list.Prev)
+			},
+		},
+		{
+			name:           "Last page",
+			query:          "?page=3&limit=20",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, list *handler.ListResponse) {
+				assert.Len(t, list.Items, 5)
+				assert.Equal(t, "pokemon-41", list.Items[0].Name)
+				assert.Nil(t, list.Next)
+				require.NotNil(t, list.Prev)
+				assert.Equal(t, "/api/v1/pokemon?limit=20&page=2", *list.Prev)
+			},
+		},
+		{
+			name:           "Limit too large",
+			query:          "?limit=200",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid page",
+			query:          "?page=0",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Page too large",
+			query:          "?page=9223372036854775807&limit=100",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Non-numeric limit",
+			query:          "?limit=abc",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.checkResponse != nil && w.Code == http.StatusOK {
+				var list handler.ListResponse
+				err := json.NewDecoder(w.Body).Decode(&list)
+				require.NoError(t, err)
+
+				tt.checkResponse(t, &list)
+			}
+		})
+	}
+}
+
+func TestListPokemonConditionalRequest(t *testing.T) {
+	router := setupStubServer(t, stubPokemonList(45))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon?page=2", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	assert.Equal(t, "no-cache", w.Header().Get("Cache-Control"))
+
+	// Same representation: 304 with no body
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/pokemon?page=2", nil)
+	req.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Empty(t, w.Body.Bytes())
+	assert.Equal(t, etag, w.Header().Get("ETag"))
+
+	// Different page: different ETag, full response
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/pokemon?page=3", nil)
+	req.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotEqual(t, etag, w.Header().Get("ETag"))
+}
@@ -0,0 +1,289 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// localizationFixtures serves Pikachu with its species, type, abilities and moves, all with names arrays
+var localizationFixtures = map[string]string{
+	"/pokemon/pikachu":         "pokemon_response.json",
+	"/pokemon-species/pikachu": "species_response.json",
+	"/type/electric":           "type_electric_response.json",
+	"/ability/static":          "ability_static_response.json",
+	"/ability/lightning-rod":   "ability_lightning_rod_response.json",
+	"/move/thunder-shock":      "move_thunder_shock_response.json",
+	"/move/quick-attack":       "move_quick_attack_response.json",
+	"/move/thunderbolt":        "move_thunderbolt_response.json",
+	"/move/volt-tackle":        "move_volt_tackle_response.json",
+}
+
+func TestGetTypeLocalized(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, localizationFixtures))
+
+	tests := []struct {
+		name             string
+		path             string
+		acceptLanguage   string
+		expectedName     string
+		expectedLanguage string
+	}{
+		{
+			name:             "Default language",
+			path:             "/api/v1/types/electric",
+			expectedName:     "Electric",
+			expectedLanguage: "en",
+		},
+		{
+			name:             "Accept-Language",
+			path:             "/api/v1/types/electric",
+			acceptLanguage:   "de;q=0.5, es-MX",
+			expectedName:     "Eléctrico",
+			expectedLanguage: "es",
+		},
+		{
+			name:             "Query parameter overrides Accept-Language",
+			path:             "/api/v1/types/electric?lang=fr",
+			acceptLanguage:   "es",
+			expectedName:     "Électrik",
+			expectedLanguage: "fr",
+		},
+		{
+			name:             "Zero quality is excluded",
+			path:             "/api/v1/types/electric",
+			acceptLanguage:   "es;q=0, it;q=0.8, *",
+			expectedName:     "Elettro",
+			expectedLanguage: "it",
+		},
+		{
+			name:             "Language matches its script variants",
+			path:             "/api/v1/types/electric",
+			acceptLanguage:   "zh",
+			expectedName:     "電",
+			expectedLanguage: "zh-Hant",
+		},
+		{
+			name:             "Falls back to English",
+			path:             "/api/v1/types/electric?lang=xx,yy",
+			expectedName:     "Electric",
+			expectedLanguage: "en",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.expectedLanguage, w.Header().Get("Content-Language"))
+			assert.Contains(t, w.Header().Values("Vary"), "Accept-Language")
+
+			var details domain.TypeDetails
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&details))
+			require.NotNil(t, details.LocalizedName)
+			assert.Equal(t, tt.expectedName, details.LocalizedName.Name)
+			assert.Equal(t, tt.expectedLanguage, details.LocalizedName.Language)
+		})
+	}
+}
+
+func TestGetAbilityAndMoveLocalized(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, localizationFixtures))
+
+	t.Run("Ability name and effect in different languages", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/abilities/static", nil)
+		req.Header.Set("Accept-Language", "fr-CA, fr;q=0.9")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		// Effect text has not been translated into French
+		assert.Equal(t, "fr, en", w.Header().Get("Content-Language"))
+
+		var ability domain.AbilitySummary
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&ability))
+		require.NotNil(t, ability.LocalizedName)
+		assert.Equal(t, "Statik", ability.LocalizedName.Name)
+		assert.Equal(t, "en", ability.Effect.Language)
+	})
+
+	t.Run("Move name in Japanese kana", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/moves/thunderbolt?lang=ja", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var move domain.MoveSummary
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&move))
+		require.NotNil(t, move.LocalizedName)
+		assert.Equal(t, "10まんボルト", move.LocalizedName.Name)
+		assert.Equal(t, "ja-Hrkt", move.LocalizedName.Language)
+	})
+}
+
+func TestGetPokemonSpeciesLocalized(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, localizationFixtures))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu/species", nil)
+	req.Header.Set("Accept-Language", "fr-FR")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "fr", w.Header().Get("Content-Language"))
+
+	var species domain.SpeciesSummary
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&species))
+	require.NotNil(t, species.LocalizedName)
+	assert.Equal(t, "Pikachu", species.LocalizedName.Name)
+	assert.Equal(t, "fr", species.Language)
+	assert.Equal(t, "Pokémon Souris", species.Genus)
+	assert.NotEmpty(t, species.FlavorText)
+}
+
+func TestGetPokemonLocalized(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, localizationFixtures))
+
+	t.Run("Names are localized when a language is requested", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu", nil)
+		req.Header.Set("Accept-Language", "ja")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "ja", w.Header().Get("Content-Language"))
+
+		var pokemon domain.Pokemon
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&pokemon))
+		require.NotNil(t, pokemon.LocalizedName)
+		assert.Equal(t, "ピカチュウ", pokemon.LocalizedName.Name)
+
+		require.Len(t, pokemon.Types, 1)
+		require.NotNil(t, pokemon.Types[0].Type.LocalizedName)
+		assert.Equal(t, "でんき", pokemon.Types[0].Type.LocalizedName.Name)
+
+		require.Len(t, pokemon.Abilities, 2)
+		for _, ability := range pokemon.Abilities {
+			require.NotNil(t, ability.Ability.LocalizedName, ability.Ability.Name)
+			assert.Equal(t, "ja-Hrkt", ability.Ability.LocalizedName.Language)
+		}
+
+		require.Len(t, pokemon.Moves, 4)
+		for _, move := range pokemon.Moves {
+			require.NotNil(t, move.Move.LocalizedName, move.Move.Name)
+			assert.Equal(t, "ja-Hrkt", move.Move.LocalizedName.Language)
+		}
+		assert.Equal(t, "10まんボルト", pokemon.Moves[2].Move.LocalizedName.Name)
+	})
+
+	t.Run("Names are not localized for English speakers", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu", nil)
+		req.Header.Set("Accept-Language", "en-US, en;q=0.9, ja;q=0.5")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("Content-Language"))
+		assert.NotContains(t, w.Body.String(), "localized_name")
+
+		// Unless they ask for a language explicitly
+		req = httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu?lang=en", nil)
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "en", w.Header().Get("Content-Language"))
+	})
+
+	t.Run("Names are left out when they cannot be fetched", func(t *testing.T) {
+		router := setupStubServer(t, stubFixtures(t, map[string]string{
+			"/pokemon/pikachu":         "pokemon_response.json",
+			"/pokemon-species/pikachu": "species_response.json",
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu?lang=ja", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("Content-Language"))
+		assert.NotContains(t, w.Body.String(), "localized_name")
+	})
+
+	t.Run("Names are not localized by default", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("Content-Language"))
+
+		var pokemon domain.Pokemon
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&pokemon))
+		assert.Nil(t, pokemon.LocalizedName)
+		assert.Nil(t, pokemon.Types[0].Type.LocalizedName)
+	})
+}
+
+func TestGetPokemonMovesLocalized(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, localizationFixtures))
+
+	t.Run("Move names follow the fallback chain", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu/moves?method=level-up", nil)
+		req.Header.Set("Accept-Language", "es-MX, fr;q=0.5")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "es", w.Header().Get("Content-Language"))
+
+		var moves domain.PokemonMoves
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&moves))
+		require.NotEmpty(t, moves.Moves)
+
+		names := map[string]string{}
+		for _, move := range moves.Moves {
+			require.NotNil(t, move.LocalizedName, move.Name)
+			assert.Equal(t, "es", move.LocalizedName.Language)
+			names[move.Name] = move.LocalizedName.Name
+		}
+		assert.Equal(t, "Impactrueno", names["thunder-shock"])
+	})
+
+	t.Run("Move names are not localized by default", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu/moves", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("Content-Language"))
+		assert.NotContains(t, w.Body.String(), "localized_name")
+	})
+
+	t.Run("Move names are left out when they cannot be fetched", func(t *testing.T) {
+		router := setupStubServer(t, stubFixtures(t, map[string]string{
+			"/pokemon/pikachu":  "pokemon_response.json",
+			"/move/thunderbolt": "move_thunderbolt_response.json",
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu/moves?lang=ja", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("Content-Language"))
+		assert.NotContains(t, w.Body.String(), "localized_name")
+	})
+}
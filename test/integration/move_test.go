@@ -0,0 +1,99 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPokemonMoves(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, map[string]string{
+		"/pokemon/pikachu": "pokemon_response.json",
+	}))
+
+	tests := []struct {
+		name          string
+		query         string
+		expectedMoves []domain.LearnableMove
+		expectedCount int
+	}{
+		{
+			name:          "All moves",
+			query:         "",
+			expectedCount: 8,
+		},
+		{
+			name:  "Level-up moves in one version group",
+			query: "?version_group=red-blue&method=level-up",
+			expectedMoves: []domain.LearnableMove{
+				{Name: "thunder-shock", Method: "level-up", Level: 1, VersionGroup: "red-blue"},
+				{Name: "quick-attack", Method: "level-up", Level: 16, VersionGroup: "red-blue"},
+			},
+		},
+		{
+			name:  "Egg moves",
+			query: "?method=egg",
+			expectedMoves: []domain.LearnableMove{
+				{Name: "volt-tackle", Method: "egg", Level: 0, VersionGroup: "sword-shield"},
+			},
+		},
+		{
+			name:          "Unknown version group",
+			query:         "?version_group=pokemon-gold",
+			expectedMoves: []domain.LearnableMove{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu/moves"+tt.query, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var moves domain.PokemonMoves
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&moves))
+			assert.Equal(t, "pikachu", moves.Pokemon)
+
+			if tt.expectedMoves != nil {
+				assert.Equal(t, tt.expectedMoves, moves.Moves)
+			} else {
+				assert.Len(t, moves.Moves, tt.expectedCount)
+			}
+		})
+	}
+}
+
+func TestGetMove(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, map[string]string{
+		"/move/thunderbolt": "move_thunderbolt_response.json",
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/moves/thunderbolt", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var move domain.MoveSummary
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&move))
+	assert.Equal(t, "electric", move.Type)
+	assert.Equal(t, "special", move.DamageClass)
+	require.NotNil(t, move.Power)
+	assert.Equal(t, 90, *move.Power)
+	require.NotNil(t, move.PP)
+	assert.Equal(t, 15, *move.PP)
+	assert.Equal(t, "Has a 10% chance to paralyze the target.", move.Effect.ShortEffect)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/moves/struggle-harder", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
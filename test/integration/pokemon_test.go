@@ -33,7 +33,7 @@ func setupTestServer(t *testing.T) http.Handler {
 	pokemonService := service.NewPokemonService(pokemonClient, log)
 
 	// Create handlers
-	h := handler.NewHandler(pokemonService, log)
+	h := handler.NewHandler(pokemonService, log, handler.WithUpstreamMonitor(pokemonClient))
 
 	// Setup routes
 	router := server.SetupRoutes(h, log, "*")
@@ -57,6 +57,8 @@ func TestHealthCheckEndpoint(t *testing.T) {
 
 	assert.Equal(t, "ok", response.Status)
 	assert.NotZero(t, response.Timestamp)
+	require.NotNil(t, response.Upstream)
+	assert.Equal(t, domain.CircuitClosed, response.Upstream.CircuitBreaker)
 }
 
 func TestGetPokemonByName(t *testing.T) {
@@ -0,0 +1,111 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubFixtures serves testdata fixtures keyed by upstream path, and 404 for anything else
+func stubFixtures(t *testing.T, fixtures map[string]string) http.HandlerFunc {
+	bodies := make(map[string][]byte, len(fixtures))
+	for path, file := range fixtures {
+		body, err := os.ReadFile("../testdata/" + file)
+		require.NoError(t, err)
+		bodies[path] = body
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, ok := bodies[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}
+}
+
+func TestGetPokemonSpecies(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, map[string]string{
+		"/pokemon/pikachu":         "pokemon_response.json",
+		"/pokemon-species/pikachu": "species_response.json",
+	}))
+
+	tests := []struct {
+		name           string
+		path           string
+		expectedStatus int
+		checkResponse  func(t *testing.T, species *domain.SpeciesSummary)
+	}{
+		{
+			name:           "Default language",
+			path:           "/api/v1/pokemon/pikachu/species",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, species *domain.SpeciesSummary) {
+				assert.Equal(t, 25, species.ID)
+				assert.Equal(t, "en", species.Language)
+				assert.Equal(t, "Mouse Pokémon", species.Genus)
+				assert.Equal(t, 190, species.CaptureRate)
+				require.NotNil(t, species.GenderRatio)
+				assert.Equal(t, 50.0, species.GenderRatio.Female)
+				assert.Equal(t, "pichu", species.EvolvesFrom)
+
+				// Red and Blue share an entry
+				require.Len(t, species.FlavorText, 2)
+				assert.Equal(t, []string{"red", "blue"}, species.FlavorText[0].Versions)
+				assert.Equal(t, "When several of these POKéMON gather, their electricity could build and cause lightning storms.", species.FlavorText[0].Text)
+			},
+		},
+		{
+			name:           "Filtered by version",
+			path:           "/api/v1/pokemon/pikachu/species?version=yellow",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, species *domain.SpeciesSummary) {
+				require.Len(t, species.FlavorText, 1)
+				assert.Equal(t, []string{"yellow"}, species.FlavorText[0].Versions)
+			},
+		},
+		{
+			name:           "Other language",
+			path:           "/api/v1/pokemon/pikachu/species?lang=FR",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, species *domain.SpeciesSummary) {
+				assert.Equal(t, "Pokémon Souris", species.Genus)
+				require.Len(t, species.FlavorText, 1)
+				assert.Equal(t, []string{"x"}, species.FlavorText[0].Versions)
+			},
+		},
+		{
+			name:           "Unknown Pokemon",
+			path:           "/api/v1/pokemon/missingno/species",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.checkResponse != nil && w.Code == http.StatusOK {
+				var species domain.SpeciesSummary
+				err := json.NewDecoder(w.Body).Decode(&species)
+				require.NoError(t, err)
+
+				tt.checkResponse(t, &species)
+			}
+		})
+	}
+}
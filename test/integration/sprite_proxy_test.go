@@ -0,0 +1,197 @@
+package integration
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// spriteImage is a 4x2 sprite: a red left half and a transparent right half
+func spriteImage(t *testing.T) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 2))
+	for y := 0; y < 2; y++ {
+		img.Set(0, y, color.NRGBA{R: 255, A: 255})
+		img.Set(1, y, color.NRGBA{R: 255, A: 255})
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+// stubSprites serves Pikachu with its sprite URLs pointing back at the stub, and the front sprite.
+// Other sprites are missing. Downloads of the front sprite are counted.
+func stubSprites(t *testing.T, sprite []byte, downloads *int64) http.HandlerFunc {
+	fixture, err := os.ReadFile("../testdata/pokemon_response.json")
+	require.NoError(t, err)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pokemon/pikachu":
+			body := bytes.ReplaceAll(fixture,
+				[]byte("https://raw.githubusercontent.com/PokeAPI/sprites/master"),
+				[]byte("http://"+r.Host))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(body)
+		case "/sprites/pokemon/25.png":
+			atomic.AddInt64(downloads, 1)
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write(sprite)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+func TestGetPokemonSprite(t *testing.T) {
+	var downloads int64
+	router := setupStubServer(t, stubSprites(t, spriteImage(t), &downloads))
+
+	get := func(url string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("Original size", func(t *testing.T) {
+		w := get("/api/v1/pokemon/pikachu/sprite/front-default")
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "image/png", w.Header().Get("Content-Type"))
+		assert.NotEmpty(t, w.Header().Get("ETag"))
+
+		img, err := png.Decode(w.Body)
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 4, 2), img.Bounds())
+	})
+
+	t.Run("Enlarged keeps pixels sharp", func(t *testing.T) {
+		w := get("/api/v1/pokemon/pikachu/sprite/front_default?size=8")
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		img, err := png.Decode(w.Body)
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 8, 4), img.Bounds())
+		assert.Equal(t, color.NRGBA{R: 255, A: 255}, color.NRGBAModel.Convert(img.At(3, 3)))
+		_, _, _, a := img.At(4, 0).RGBA()
+		assert.Zero(t, a)
+	})
+
+	t.Run("Shrunk averages pixels", func(t *testing.T) {
+		w := get("/api/v1/pokemon/pikachu/sprite/front-default?size=1")
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		img, err := png.Decode(w.Body)
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 1, 1), img.Bounds())
+
+		// Half red, half transparent: half-opaque red
+		pixel := color.NRGBAModel.Convert(img.At(0, 0)).(color.NRGBA)
+		assert.Equal(t, uint8(255), pixel.R)
+		assert.InDelta(t, 127, int(pixel.A), 1)
+	})
+
+	t.Run("JPEG", func(t *testing.T) {
+		w := get("/api/v1/pokemon/pikachu/sprite/front-default?format=jpeg&size=4")
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "image/jpeg", w.Header().Get("Content-Type"))
+
+		img, err := jpeg.Decode(w.Body)
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 4, 2), img.Bounds())
+	})
+
+	t.Run("Rendered sprites are cached", func(t *testing.T) {
+		atomic.StoreInt64(&downloads, 0)
+
+		for i := 0; i < 3; i++ {
+			w := get("/api/v1/pokemon/pikachu/sprite/front-default?size=16")
+			require.Equal(t, http.StatusOK, w.Code)
+		}
+
+		assert.Equal(t, int64(1), atomic.LoadInt64(&downloads))
+	})
+
+	t.Run("Sizes are rounded up to a power of two", func(t *testing.T) {
+		atomic.StoreInt64(&downloads, 0)
+
+		// 12 is rendered at 16, which is already cached
+		w := get("/api/v1/pokemon/pikachu/sprite/front-default?size=12")
+		require.Equal(t, http.StatusOK, w.Code)
+
+		img, err := png.Decode(w.Body)
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 16, 8), img.Bounds())
+		assert.Zero(t, atomic.LoadInt64(&downloads))
+	})
+
+	t.Run("Concurrent renders are shared", func(t *testing.T) {
+		atomic.StoreInt64(&downloads, 0)
+
+		var wg sync.WaitGroup
+		codes := make(chan int, 10)
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				codes <- get("/api/v1/pokemon/pikachu/sprite/front-default?size=64&format=jpeg").Code
+			}()
+		}
+		wg.Wait()
+		close(codes)
+
+		for code := range codes {
+			assert.Equal(t, http.StatusOK, code)
+		}
+		assert.Equal(t, int64(1), atomic.LoadInt64(&downloads))
+	})
+
+	t.Run("Missing sprite", func(t *testing.T) {
+		w := get("/api/v1/pokemon/pikachu/sprite/official-artwork")
+
+		require.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "Sprite not found")
+	})
+
+	for _, url := range []string{
+		"/api/v1/pokemon/pikachu/sprite/sideways",
+		"/api/v1/pokemon/pikachu/sprite/front-default?size=4096",
+		"/api/v1/pokemon/pikachu/sprite/front-default?size=-1",
+		"/api/v1/pokemon/pikachu/sprite/front-default?size=big",
+		"/api/v1/pokemon/pikachu/sprite/front-default?format=gif",
+	} {
+		t.Run("Invalid "+url, func(t *testing.T) {
+			assert.Equal(t, http.StatusBadRequest, get(url).Code)
+		})
+	}
+}
+
+func TestGetPokemonSpriteTooLarge(t *testing.T) {
+	// A blank image compresses to a small file however many pixels it declares
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewNRGBA(image.Rect(0, 0, 2049, 2048))))
+
+	var downloads int64
+	router := setupStubServer(t, stubSprites(t, buf.Bytes(), &downloads))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu/sprite/front-default?size=0", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadGateway, w.Code)
+}
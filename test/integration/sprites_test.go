@@ -0,0 +1,111 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPokemonSprites(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, map[string]string{
+		"/pokemon/pikachu": "pokemon_response.json",
+	}))
+
+	t.Run("Full catalog", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var pokemon domain.Pokemon
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&pokemon))
+		assert.Contains(t, pokemon.Sprites.FrontFemale, "female/25.png")
+		assert.Contains(t, pokemon.Sprites.Other.OfficialArtwork.FrontDefault, "official-artwork/25.png")
+		assert.Empty(t, pokemon.Sprites.Other.DreamWorld.FrontFemale)
+		require.Contains(t, pokemon.Sprites.Versions, "generation-i")
+		assert.Contains(t, pokemon.Sprites.Versions["generation-i"]["yellow"].FrontGray, "yellow/gray/25.png")
+	})
+
+	tests := []struct {
+		name           string
+		spriteSet      string
+		expectedStatus int
+		checkResponse  func(t *testing.T, sprites *domain.SpriteSet)
+	}{
+		{
+			name:           "Official artwork",
+			spriteSet:      "official-artwork",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, sprites *domain.SpriteSet) {
+				assert.Contains(t, sprites.FrontDefault, "official-artwork/25.png")
+				assert.Contains(t, sprites.FrontShiny, "official-artwork/shiny/25.png")
+				assert.Empty(t, sprites.BackDefault)
+			},
+		},
+		{
+			name:           "Default",
+			spriteSet:      "default",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, sprites *domain.SpriteSet) {
+				assert.Contains(t, sprites.FrontDefault, "pokemon/25.png")
+				assert.Contains(t, sprites.BackShinyFemale, "back/shiny/female/25.png")
+			},
+		},
+		{
+			name:           "Game version",
+			spriteSet:      "generation-i/red-blue",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, sprites *domain.SpriteSet) {
+				assert.Contains(t, sprites.FrontTransparent, "red-blue/transparent/25.png")
+				assert.Nil(t, sprites.Animated)
+			},
+		},
+		{
+			name:           "Animated",
+			spriteSet:      "Generation-V/Black-White/animated",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, sprites *domain.SpriteSet) {
+				assert.Contains(t, sprites.FrontDefault, "black-white/animated/25.gif")
+			},
+		},
+		{
+			name:           "Game without animated sprites",
+			spriteSet:      "generation-i/yellow/animated",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Unknown set",
+			spriteSet:      "pixel-art",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu?sprite_set="+tt.spriteSet, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.checkResponse != nil && w.Code == http.StatusOK {
+				var resp struct {
+					Name    string           `json:"name"`
+					Sprites domain.SpriteSet `json:"sprites"`
+				}
+				err := json.NewDecoder(w.Body).Decode(&resp)
+				require.NoError(t, err)
+
+				assert.Equal(t, "pikachu", resp.Name)
+				tt.checkResponse(t, &resp.Sprites)
+			}
+		})
+	}
+}
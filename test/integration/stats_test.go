@@ -0,0 +1,296 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/polgarcia/golang-rest-api/internal/client"
+	"github.com/polgarcia/golang-rest-api/internal/config"
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"github.com/polgarcia/golang-rest-api/internal/handler"
+	"github.com/polgarcia/golang-rest-api/internal/server"
+	"github.com/polgarcia/golang-rest-api/internal/service"
+	"github.com/polgarcia/golang-rest-api/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// statsFixtures serves a population of six Pokemon and the types of two of them
+var statsFixtures = map[string]string{
+	"/pokemon":              "pokemon_list_response.json",
+	"/pokemon/pikachu":      "pokemon_response.json",
+	"/pokemon/gligar":       "gligar_response.json",
+	"/pokemon/meowth":       "meowth_response.json",
+	"/pokemon/meowth-alola": "meowth_alola_response.json",
+	"/pokemon/meowth-galar": "meowth_galar_response.json",
+	"/pokemon/meowth-gmax":  "meowth_gmax_response.json",
+	"/type/electric":        "type_electric_response.json",
+	"/type/normal":          "type_normal_response.json",
+}
+
+// getStats requests the stat analytics of a Pokemon and decodes a successful response
+func getStats(t *testing.T, router http.Handler, nameOrID string) (*httptest.ResponseRecorder, *domain.PokemonStats) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/"+nameOrID+"/stats", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		return w, nil
+	}
+
+	var stats domain.PokemonStats
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&stats))
+	return w, &stats
+}
+
+// findStat returns the analysis of the named stat
+func findStat(t *testing.T, stats *domain.PokemonStats, name string) domain.StatAnalysis {
+	for _, stat := range stats.Stats {
+		if stat.Name == name {
+			return stat
+		}
+	}
+	require.Failf(t, "stat not found", "%s has no %s stat", stats.Name, name)
+	return domain.StatAnalysis{}
+}
+
+func TestGetPokemonStats(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, statsFixtures))
+
+	t.Run("Totals and percentiles", func(t *testing.T) {
+		w, stats := getStats(t, router, "pikachu")
+		require.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, "electric", stats.PrimaryType)
+		assert.Equal(t, 6, stats.Population)
+		assert.Equal(t, 320, stats.Total.Base)
+		// Five of six Pokemon have a lower total
+		assert.Equal(t, 91.7, stats.Total.Percentile)
+
+		// One Pokemon is slower and three are as fast, which count half
+		speed := findStat(t, stats, "speed")
+		assert.Equal(t, 90, speed.Base)
+		assert.Equal(t, 60.0, speed.Percentile)
+	})
+
+	t.Run("Computed stats", func(t *testing.T) {
+		_, stats := getStats(t, router, "pikachu")
+
+		hp := findStat(t, stats, "hp")
+		assert.Equal(t, domain.StatRange{Min: 95, Max: 142}, hp.Level50.Neutral)
+		assert.Equal(t, domain.StatRange{Min: 180, Max: 274}, hp.Level100.Neutral)
+		assert.Nil(t, hp.Level50.Beneficial, "natures do not affect HP")
+		assert.Nil(t, hp.Level50.Hindering, "natures do not affect HP")
+
+		speed := findStat(t, stats, "speed")
+		assert.Equal(t, domain.StatRange{Min: 95, Max: 142}, speed.Level50.Neutral)
+		require.NotNil(t, speed.Level50.Beneficial)
+		assert.Equal(t, domain.StatRange{Min: 104, Max: 156}, *speed.Level50.Beneficial)
+		require.NotNil(t, speed.Level50.Hindering)
+		assert.Equal(t, domain.StatRange{Min: 85, Max: 127}, *speed.Level50.Hindering)
+		assert.Equal(t, domain.StatRange{Min: 185, Max: 279}, speed.Level100.Neutral)
+		assert.Equal(t, domain.StatRange{Min: 203, Max: 306}, *speed.Level100.Beneficial)
+		assert.Equal(t, domain.StatRange{Min: 166, Max: 251}, *speed.Level100.Hindering)
+	})
+
+	t.Run("Rank within primary type", func(t *testing.T) {
+		// Only Meowth and Gigantamax Meowth of the normal type are in the population
+		_, stats := getStats(t, router, "meowth")
+		assert.Equal(t, "normal", stats.PrimaryType)
+		assert.Equal(t, domain.StatRank{Rank: 1, Of: 2}, stats.Total.TypeRank, "ties share a rank")
+
+		_, stats = getStats(t, router, "pikachu")
+		assert.Equal(t, domain.StatRank{Rank: 1, Of: 1}, stats.Total.TypeRank)
+	})
+
+	t.Run("Unknown Pokemon", func(t *testing.T) {
+		w, _ := getStats(t, router, "missingno")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestGetPokemonStatsRanksPrimaryTypeOnly(t *testing.T) {
+	// Bloodmoon Ursaluna is ground and normal, so it is listed by the normal type in slot 2
+	router := setupStubServer(t, stubFixtures(t, map[string]string{
+		"/pokemon":                    "pokemon_list_normal_response.json",
+		"/pokemon/meowth":             "meowth_response.json",
+		"/pokemon/meowth-gmax":        "meowth_gmax_response.json",
+		"/pokemon/ursaluna-bloodmoon": "ursaluna_bloodmoon_response.json",
+		"/type/normal":                "type_normal_response.json",
+	}))
+
+	w, stats := getStats(t, router, "meowth")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	assert.Equal(t, 3, stats.Population)
+	assert.Equal(t, domain.StatRank{Rank: 1, Of: 2}, stats.Total.TypeRank)
+}
+
+func TestGetPokemonStatsBuildsDistributionOnce(t *testing.T) {
+	fixtures := stubFixtures(t, statsFixtures)
+	var listHits int64
+	router := setupStubServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/pokemon" {
+			atomic.AddInt64(&listHits, 1)
+		}
+		fixtures(w, r)
+	}))
+
+	for _, name := range []string{"pikachu", "meowth", "pikachu"} {
+		w, _ := getStats(t, router, name)
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+
+	// The count and the listing, fetched once
+	assert.Equal(t, int64(2), atomic.LoadInt64(&listHits))
+}
+
+func TestGetPokemonStatsWhileBuilding(t *testing.T) {
+	fixtures := stubFixtures(t, statsFixtures)
+	release := make(chan struct{})
+	var releaseOnce sync.Once
+	router := setupStubServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/pokemon/gligar" {
+			<-release
+		}
+		fixtures(w, r)
+	}))
+	t.Cleanup(func() { releaseOnce.Do(func() { close(release) }) })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pikachu/stats", nil).WithContext(ctx)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "30", w.Header().Get("Retry-After"))
+	assert.Contains(t, w.Body.String(), "Stat distribution is not ready yet")
+
+	// The build carries on without the request that started it
+	releaseOnce.Do(func() { close(release) })
+	w, stats := getStats(t, router, "pikachu")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 6, stats.Population)
+}
+
+// newStatsPopulationRouter serves a population of 20 Pokemon, each with Pikachu's stats,
+// through a cached client that does not retry. Requests for the Pokemon in failing
+// answer 500, and requests for every Pokemon are counted.
+func newStatsPopulationRouter(t *testing.T, failing map[string]bool) (http.Handler, *int64) {
+	log, err := logger.New("error", "console")
+	require.NoError(t, err)
+
+	fixture, err := os.ReadFile("../testdata/pokemon_response.json")
+	require.NoError(t, err)
+	typeFixture, err := os.ReadFile("../testdata/type_electric_response.json")
+	require.NoError(t, err)
+
+	list := stubPokemonList(20)
+	var hits int64
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/pokemon":
+			list(w, r)
+		case r.URL.Path == "/type/electric":
+			_, _ = w.Write(typeFixture)
+		case strings.HasPrefix(r.URL.Path, "/pokemon/pokemon-"):
+			atomic.AddInt64(&hits, 1)
+			if failing[strings.TrimPrefix(r.URL.Path, "/pokemon/")] {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			_, _ = w.Write(fixture)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(upstream.Close)
+
+	cached, err := client.NewCachedClient(
+		client.NewPokeAPIClient(upstream.URL, 5*time.Second, log,
+			client.WithRetryPolicy(config.RetryConfig{MaxAttempts: 1, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}),
+			client.WithCircuitBreaker(config.BreakerConfig{FailureThreshold: 100, Cooldown: time.Minute, HalfOpenRequests: 1}),
+		),
+		config.CacheConfig{
+			Enabled:     true,
+			Backend:     "memory",
+			TTL:         time.Minute,
+			NegativeTTL: time.Minute,
+			MaxEntries:  100,
+		},
+		log,
+	)
+	require.NoError(t, err)
+
+	h := handler.NewHandler(service.NewPokemonService(cached, log), log)
+	return server.SetupRoutes(h, log, "*"), &hits
+}
+
+func TestGetPokemonStatsWhileBuildingWithoutDeadline(t *testing.T) {
+	fixtures := stubFixtures(t, statsFixtures)
+	release := make(chan struct{})
+	var releaseOnce sync.Once
+	router := setupStubServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/pokemon/gligar" {
+			<-release
+		}
+		fixtures(w, r)
+	}))
+	t.Cleanup(func() { releaseOnce.Do(func() { close(release) }) })
+
+	// Server requests carry no deadline, so the wait for the build is bounded by the service
+	start := time.Now()
+	w, _ := getStats(t, router, "pikachu")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "30", w.Header().Get("Retry-After"))
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestGetPokemonStatsLeavesOutFailedPokemon(t *testing.T) {
+	router, hits := newStatsPopulationRouter(t, map[string]bool{"pokemon-7": true})
+
+	w, stats := getStats(t, router, "pokemon-3")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 19, stats.Population)
+
+	// The population was fetched past the response cache, so the Pokemon is fetched
+	// again when it is requested itself, and only then cached
+	before := atomic.LoadInt64(hits)
+	for i := 0; i < 2; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/pokemon-12", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+	assert.Equal(t, before+1, atomic.LoadInt64(hits))
+}
+
+func TestGetPokemonStatsBacksOffAfterFailedBuild(t *testing.T) {
+	router, hits := newStatsPopulationRouter(t, map[string]bool{
+		"pokemon-2": true,
+		"pokemon-4": true,
+		"pokemon-6": true,
+	})
+
+	// Three of twenty is more than a build may leave out
+	w, _ := getStats(t, router, "pokemon-1")
+	assert.Equal(t, http.StatusBadGateway, w.Code)
+
+	// The next request does not start another build
+	before := atomic.LoadInt64(hits)
+	w, _ = getStats(t, router, "pokemon-1")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.NotEmpty(t, w.Header().Get("Retry-After"))
+	assert.Contains(t, w.Body.String(), "Stat distribution is not ready yet")
+	assert.Equal(t, before, atomic.LoadInt64(hits))
+}
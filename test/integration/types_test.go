@@ -0,0 +1,124 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/polgarcia/golang-rest-api/internal/domain"
+	"github.com/polgarcia/golang-rest-api/internal/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// typeFixtures serves the type resources used by the type tests
+var typeFixtures = map[string]string{
+	"/type":            "type_list_response.json",
+	"/type/electric":   "type_electric_response.json",
+	"/type/ground":     "type_ground_response.json",
+	"/type/flying":     "type_flying_response.json",
+	"/pokemon/pikachu": "pokemon_response.json",
+	"/pokemon/gligar":  "gligar_response.json",
+}
+
+func TestTypes(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, typeFixtures))
+
+	t.Run("List types", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/types?limit=100", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var list handler.ListResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&list))
+		assert.Equal(t, 21, list.Total)
+		assert.Equal(t, "normal", list.Items[0].Name)
+	})
+
+	t.Run("Get type", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/types/Electric", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var details domain.TypeDetails
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&details))
+		assert.Equal(t, "electric", details.Name)
+		assert.Equal(t, 2.0, details.DamageFrom("ground"))
+		assert.Equal(t, 0.5, details.DamageFrom("steel"))
+		assert.Equal(t, 1.0, details.DamageFrom("water"))
+	})
+
+	t.Run("Unknown type", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/types/cosmic", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		var errResp handler.ErrorResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&errResp))
+		assert.Equal(t, "Type not found", errResp.Message)
+	})
+}
+
+func TestGetPokemonMatchups(t *testing.T) {
+	router := setupStubServer(t, stubFixtures(t, typeFixtures))
+
+	tests := []struct {
+		name        string
+		pokemon     string
+		multipliers map[string]float64
+		check       func(t *testing.T, matchups *domain.TypeMatchups)
+	}{
+		{
+			name:    "Single type",
+			pokemon: "pikachu",
+			multipliers: map[string]float64{
+				"ground": 2, "steel": 0.5, "electric": 0.5, "water": 1,
+			},
+			check: func(t *testing.T, matchups *domain.TypeMatchups) {
+				assert.Equal(t, []string{"electric"}, matchups.Types)
+				assert.Equal(t, []string{"ground"}, matchups.Double)
+				assert.Empty(t, matchups.Immune)
+			},
+		},
+		{
+			name:    "Dual type",
+			pokemon: "gligar",
+			multipliers: map[string]float64{
+				"ice": 4, "water": 2, "electric": 0, "ground": 0, "bug": 0.5, "rock": 1, "grass": 1,
+			},
+			check: func(t *testing.T, matchups *domain.TypeMatchups) {
+				assert.Equal(t, []string{"ground", "flying"}, matchups.Types)
+				assert.Equal(t, []string{"ice"}, matchups.Quadruple)
+				assert.ElementsMatch(t, []string{"ground", "electric"}, matchups.Immune)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/pokemon/"+tt.pokemon+"/matchups", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var matchups domain.TypeMatchups
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&matchups))
+
+			// Only battle types are rated
+			assert.Len(t, matchups.Multipliers, 18)
+			assert.NotContains(t, matchups.Multipliers, "shadow")
+			for attack, multiplier := range tt.multipliers {
+				assert.Equal(t, multiplier, matchups.Multipliers[attack], attack)
+			}
+			tt.check(t, &matchups)
+		})
+	}
+}